@@ -18,8 +18,10 @@ func main() {
 	cmd.AddCommand(
 		base.NewCmd(),
 		base.DescribeCmd(),
+		base.DiffCmd(),
 		base.GenerateCmd(),
 		base.InitCmd(),
+		base.MigrateCmd(),
 		base.SchemaCmd(),
 	)
 	cobra.CheckErr(cmd.Execute())
@@ -0,0 +1,519 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+)
+
+type (
+	// Codemod holds the flags that are shared by the commands that rewrite user code.
+	Codemod struct {
+		DryRun   bool     `name:"dry-run" help:"Print the changes as a unified diff instead of writing them"`
+		Dir      string   `name:"dir" default:"." help:"Directory of the Go module to rewrite"`
+		Patterns []string `arg:"" optional:"" default:"./..." help:"Patterns of the packages to rewrite"`
+	}
+	// Deprecated represents the 'entfix deprecated' command.
+	Deprecated struct {
+		Codemod `embed:""`
+	}
+	// Rename represents the 'entfix rename' command.
+	Rename struct {
+		Codemod `embed:""`
+		Schema  string `name:"schema" help:"Path to the schema package; renames are read from the field.RenamedFrom annotations"`
+		Package string `name:"package" help:"Import path of the generated ent package (defaults to the parent package of the schema)"`
+		Type    string `name:"type" help:"Name of the schema that its field was renamed (e.g. User)"`
+		From    string `name:"from" help:"Old name of the field (e.g. name)"`
+		To      string `name:"to" help:"New name of the field (e.g. full_name)"`
+		renames []rename
+	}
+	// rename describes a renamed field of a schema.
+	rename struct {
+		Type, From, To string
+	}
+	// Modify represents the 'entfix modify' command.
+	Modify struct {
+		Codemod `embed:""`
+	}
+)
+
+// fixFunc rewrites the given file of the package, and reports if it was changed.
+type fixFunc func(*packages.Package, *ast.File) bool
+
+// Run rewrites the usage of deprecated ent APIs.
+func (cmd *Deprecated) Run() error {
+	return cmd.run(os.Stdout, fixDeprecated)
+}
+
+// Run rewrites the usage of the generated APIs of the renamed fields.
+func (cmd *Rename) Run() error {
+	if err := cmd.load(); err != nil {
+		return err
+	}
+	return cmd.run(os.Stdout, cmd.fix)
+}
+
+// Run replaces the Modify calls that lock rows with the
+// ForUpdate and ForShare methods of the query builders.
+func (cmd *Modify) Run() error {
+	return cmd.run(os.Stdout, fixModify)
+}
+
+// run loads the packages that match the patterns, and applies fix on their non-generated files.
+// Changed files are written back to the disk, or printed to w as a unified diff in dry-run mode.
+func (c *Codemod) run(w io.Writer, fix fixFunc) error {
+	cfg := &packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
+		Dir:   c.Dir,
+		Tests: true,
+	}
+	pkgs, err := packages.Load(cfg, c.Patterns...)
+	if err != nil {
+		return fmt.Errorf("entfix: load packages: %w", err)
+	}
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return fmt.Errorf("entfix: %d errors found while loading packages", n)
+	}
+	var (
+		changed int
+		seen    = make(map[string]bool)
+	)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Syntax {
+			// Files of packages with tests are loaded more than once.
+			name := pkg.Fset.File(f.Pos()).Name()
+			if seen[name] || ast.IsGenerated(f) {
+				continue
+			}
+			seen[name] = true
+			if !fix(pkg, f) {
+				continue
+			}
+			if err := c.write(w, pkg.Fset, f, name); err != nil {
+				return err
+			}
+			changed++
+		}
+	}
+	if !c.DryRun {
+		fmt.Fprintf(w, "entfix: %d files were changed\n", changed)
+	}
+	return nil
+}
+
+// write formats the given file, and writes it to its path, or prints its diff in dry-run mode.
+func (c *Codemod) write(w io.Writer, fset *token.FileSet, f *ast.File, name string) error {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return fmt.Errorf("entfix: format %s: %w", name, err)
+	}
+	if !c.DryRun {
+		return os.WriteFile(name, buf.Bytes(), 0644)
+	}
+	prev, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(prev)),
+		B:        difflib.SplitLines(buf.String()),
+		FromFile: name,
+		ToFile:   name,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, diff)
+	return err
+}
+
+const (
+	entPkg    = "entgo.io/ent"
+	sqlPkg    = entPkg + "/dialect/sql"
+	schemaPkg = entPkg + "/dialect/sql/schema"
+)
+
+// fixDeprecated rewrites the usage of deprecated ent APIs to their replacements:
+//
+//	selector.UnionDistinct(t)			=> selector.Union(t)
+//	builder.Nested(f)				=> builder.Wrap(f)
+//	schema.Dump(ctx, dialect, version, tables)	=> schema.DDL(ctx, schema.DDLArgs{...})
+func fixDeprecated(pkg *packages.Package, f *ast.File) bool {
+	var changed bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case isMethod(pkg, sel, sqlPkg, "Selector", "UnionDistinct"):
+			sel.Sel.Name = "Union"
+			changed = true
+		case isMethod(pkg, sel, sqlPkg, "Builder", "Nested"):
+			sel.Sel.Name = "Wrap"
+			changed = true
+		case isFunc(pkg, sel, schemaPkg, "Dump"):
+			changed = fixDump(pkg, call, sel) || changed
+		}
+		return true
+	})
+	return changed
+}
+
+// fixDump rewrites a schema.Dump call to schema.DDL, if the used version of ent
+// provides it. Calls that pass the plan options one by one are skipped, as their
+// type is not known to the calling package.
+func fixDump(pkg *packages.Package, call *ast.CallExpr, sel *ast.SelectorExpr) bool {
+	names := []string{"Dialect", "Version", "Tables", "Options"}
+	switch n := len(call.Args); {
+	case pkg.TypesInfo.Uses[sel.Sel].Pkg().Scope().Lookup("DDL") == nil:
+		return false
+	case n == 5 && call.Ellipsis.IsValid():
+	case n == 4 && !call.Ellipsis.IsValid():
+	default:
+		return false
+	}
+	args := &ast.CompositeLit{
+		Type: &ast.SelectorExpr{X: sel.X, Sel: ast.NewIdent("DDLArgs")},
+	}
+	for i, arg := range call.Args[1:] {
+		args.Elts = append(args.Elts, &ast.KeyValueExpr{Key: ast.NewIdent(names[i]), Value: arg})
+	}
+	sel.Sel.Name = "DDL"
+	call.Args = []ast.Expr{call.Args[0], args}
+	call.Ellipsis = token.NoPos
+	return true
+}
+
+// load collects the renamed fields from the schema annotations, and the ones set explicitly by flags.
+func (cmd *Rename) load() error {
+	if cmd.Schema != "" {
+		spec, err := (&load.Config{Path: cmd.Schema}).Load()
+		if err != nil {
+			return fmt.Errorf("entfix: load schema: %w", err)
+		}
+		if cmd.Package == "" {
+			cmd.Package = path.Dir(spec.PkgPath)
+		}
+		if cmd.renames, err = schemaRenames(spec.Schemas); err != nil {
+			return err
+		}
+	}
+	switch {
+	case cmd.Type != "" && cmd.From != "" && cmd.To != "":
+		cmd.renames = append(cmd.renames, rename{Type: cmd.Type, From: cmd.From, To: cmd.To})
+	case cmd.Type != "" || cmd.From != "" || cmd.To != "":
+		return errors.New("entfix: the --type, --from and --to flags must be set together")
+	}
+	switch {
+	case cmd.Package == "":
+		return errors.New("entfix: missing --package or --schema flag")
+	case len(cmd.renames) == 0:
+		return errors.New("entfix: no renamed fields were found")
+	}
+	return nil
+}
+
+// renameAnnotation is the name of the field.RenameAnnotation.
+const renameAnnotation = "FieldRename"
+
+// schemaRenames returns the fields that were annotated with field.RenamedFrom.
+func schemaRenames(schemas []*load.Schema) ([]rename, error) {
+	var renames []rename
+	for _, s := range schemas {
+		for _, f := range s.Fields {
+			v, ok := f.Annotations[renameAnnotation]
+			if !ok {
+				continue
+			}
+			var ant struct{ From string }
+			buf, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(buf, &ant); err != nil {
+				return nil, fmt.Errorf("entfix: decode rename annotation of %s.%s: %w", s.Name, f.Name, err)
+			}
+			if ant.From != "" && ant.From != f.Name {
+				renames = append(renames, rename{Type: s.Name, From: ant.From, To: f.Name})
+			}
+		}
+	}
+	return renames, nil
+}
+
+// fix renames the generated identifiers of the fields in the user code. For example,
+// renaming the "name" field of the User schema to "full_name" rewrites SetName to
+// SetFullName, user.NameEQ to user.FullNameEQ, and u.Name to u.FullName.
+func (cmd *Rename) fix(pkg *packages.Package, f *ast.File) bool {
+	var changed bool
+	for _, r := range cmd.renames {
+		changed = cmd.fixRename(pkg, f, r) || changed
+	}
+	return changed
+}
+
+// fixRename renames the generated identifiers of a single field in the given file.
+func (cmd *Rename) fixRename(pkg *packages.Package, f *ast.File, r rename) bool {
+	pascal := gen.Funcs["pascal"].(func(string) string)
+	from, to := pascal(r.From), pascal(r.To)
+	// Generated package of the type (e.g. ent/user).
+	typePkg := cmd.Package + "/" + strings.ToLower(r.Type)
+	var changed bool
+	set := func(id *ast.Ident, name string) {
+		if name != id.Name {
+			id.Name = name
+			changed = true
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		obj := objectOf(pkg, sel)
+		if obj == nil || obj.Pkg() == nil {
+			return true
+		}
+		switch obj.Pkg().Path() {
+		case typePkg:
+			if name, ok := renameIdent(obj.Name(), from, to, typePkgPrefixes, typePkgSuffixes); ok {
+				set(sel.Sel, name)
+			}
+		case cmd.Package:
+			// Methods and fields are matched by the type they are selected from.
+			if s, ok := pkg.TypesInfo.Selections[sel]; !ok || !isBuilder(r.Type, namedType(s.Recv())) {
+				return true
+			}
+			if name, ok := renameIdent(obj.Name(), from, to, builderPrefixes, builderSuffixes); ok {
+				set(sel.Sel, name)
+			}
+		}
+		return true
+	})
+	return changed
+}
+
+var (
+	// Identifiers generated for a field in the type package (e.g. user.FieldName, user.NameEQ).
+	typePkgPrefixes = []string{"", "Field", "By", "Default", "UpdateDefault"}
+	typePkgSuffixes = []string{
+		"", "EQ", "NEQ", "In", "NotIn", "GT", "GTE", "LT", "LTE", "Contains", "HasPrefix",
+		"HasSuffix", "IsNil", "NotNil", "EqualFold", "ContainsFold", "Validator",
+	}
+	// Identifiers generated for a field in the builders (e.g. SetName, OldName, NameCleared).
+	builderPrefixes = []string{"", "Set", "SetNillable", "Clear", "Add", "Append", "Reset", "Old", "Added", "Appended", "Update"}
+	builderSuffixes = []string{"", "Cleared"}
+)
+
+// renameIdent returns the renamed identifier if name is a combination
+// of one of the prefixes, the field name, and one of the suffixes.
+func renameIdent(name, from, to string, prefixes, suffixes []string) (string, bool) {
+	for _, p := range prefixes {
+		for _, s := range suffixes {
+			if name == p+from+s {
+				return p + to + s, true
+			}
+		}
+	}
+	return "", false
+}
+
+// isBuilder reports if the given type name is the entity
+// or one of the builders that hold its field methods.
+func isBuilder(typ, name string) bool {
+	switch strings.TrimPrefix(name, typ) {
+	case "", "Create", "Update", "UpdateOne", "Mutation", "Upsert", "UpsertOne", "UpsertBulk":
+		return strings.HasPrefix(name, typ)
+	default:
+		return false
+	}
+}
+
+// fixModify replaces the Modify calls that only lock the selected rows
+// with the ForUpdate and ForShare methods of the query builders:
+//
+//	q.Modify(func(s *sql.Selector) { s.ForUpdate() })	=> q.ForUpdate()
+//
+// Calls are replaced only if the query builder was generated with the
+// sql/lock feature, and therefore has these methods.
+func fixModify(pkg *packages.Package, f *ast.File) bool {
+	var changed bool
+	astutil.Apply(f, nil, func(c *astutil.Cursor) bool {
+		call, ok := c.Node().(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Modify" {
+			return true
+		}
+		lock, ok := lockCall(pkg, call.Args[0])
+		if !ok || !hasMethod(pkg.TypesInfo.TypeOf(sel.X), lock.Fun.(*ast.SelectorExpr).Sel.Name) {
+			return true
+		}
+		// Positions of the lock call are cleared, as it is moved out of the function.
+		for _, arg := range lock.Args {
+			clearPos(arg)
+		}
+		c.Replace(&ast.CallExpr{
+			Fun:      &ast.SelectorExpr{X: sel.X, Sel: ast.NewIdent(lock.Fun.(*ast.SelectorExpr).Sel.Name)},
+			Lparen:   call.Lparen,
+			Args:     lock.Args,
+			Ellipsis: token.NoPos,
+			Rparen:   call.Rparen,
+		})
+		changed = true
+		return true
+	})
+	// The sql package may be used only by the replaced functions.
+	if changed && !usesPackage(pkg, f, sqlPkg) {
+		astutil.DeleteNamedImport(pkg.Fset, f, importName(f, sqlPkg), sqlPkg)
+	}
+	return changed
+}
+
+// lockCall returns the locking call of a modifier function in the form of:
+//
+//	func(s *sql.Selector) { s.ForUpdate(opts...) }
+func lockCall(pkg *packages.Package, arg ast.Expr) (*ast.CallExpr, bool) {
+	fn, ok := arg.(*ast.FuncLit)
+	if !ok || len(fn.Type.Params.List) != 1 || len(fn.Type.Params.List[0].Names) != 1 || len(fn.Body.List) != 1 {
+		return nil, false
+	}
+	stmt, ok := fn.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "ForUpdate" && sel.Sel.Name != "ForShare") || !isMethod(pkg, sel, sqlPkg, "Selector", sel.Sel.Name) {
+		return nil, false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.TypesInfo.Uses[x] != pkg.TypesInfo.Defs[fn.Type.Params.List[0].Names[0]] {
+		return nil, false
+	}
+	// The lock options must not refer to the selector.
+	var refs bool
+	for _, a := range call.Args {
+		ast.Inspect(a, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && pkg.TypesInfo.Uses[id] == pkg.TypesInfo.Uses[x] {
+				refs = true
+			}
+			return !refs
+		})
+	}
+	return call, !refs
+}
+
+// clearPos clears the positions of the given node and its children,
+// in order to print it with the default layout of the printer.
+func clearPos(n ast.Node) {
+	pos := reflect.TypeOf(token.NoPos)
+	ast.Inspect(n, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Type() == pos {
+				f.SetInt(int64(token.NoPos))
+			}
+		}
+		return true
+	})
+}
+
+// objectOf returns the object that is referenced by the given selector.
+func objectOf(pkg *packages.Package, sel *ast.SelectorExpr) types.Object {
+	if s, ok := pkg.TypesInfo.Selections[sel]; ok {
+		return s.Obj()
+	}
+	// Qualified identifiers (e.g. user.FieldName).
+	return pkg.TypesInfo.Uses[sel.Sel]
+}
+
+// namedType returns the name of the given type, or the type it points to.
+func namedType(t types.Type) string {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	if n, ok := t.(*types.Named); ok {
+		return n.Obj().Name()
+	}
+	return ""
+}
+
+// isMethod reports if the selector references the method of the named type in the given package.
+func isMethod(pkg *packages.Package, sel *ast.SelectorExpr, path, typ, name string) bool {
+	s, ok := pkg.TypesInfo.Selections[sel]
+	if !ok || s.Kind() != types.MethodVal || s.Obj().Name() != name || s.Obj().Pkg() == nil || s.Obj().Pkg().Path() != path {
+		return false
+	}
+	return namedType(s.Recv()) == typ
+}
+
+// isFunc reports if the selector references the function of the given package.
+func isFunc(pkg *packages.Package, sel *ast.SelectorExpr, path, name string) bool {
+	fn, ok := pkg.TypesInfo.Uses[sel.Sel].(*types.Func)
+	return ok && fn.Name() == name && fn.Pkg() != nil && fn.Pkg().Path() == path
+}
+
+// hasMethod reports if the given type has a method with the given name.
+func hasMethod(t types.Type, name string) bool {
+	if t == nil {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+// importName returns the explicit name of the given import path, if it exists.
+func importName(f *ast.File, path string) string {
+	for _, imp := range f.Imports {
+		if strings.Trim(imp.Path.Value, `"`) == path && imp.Name != nil {
+			return imp.Name.Name
+		}
+	}
+	return ""
+}
+
+// usesPackage reports if the given package is referenced in the file.
+func usesPackage(pkg *packages.Package, f *ast.File, path string) bool {
+	var used bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if pn, ok := pkg.TypesInfo.Uses[id].(*types.PkgName); ok && pn.Imported().Path() == path {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc/load"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecated(t *testing.T) {
+	var (
+		b   bytes.Buffer
+		cmd = &Codemod{DryRun: true, Patterns: []string{"./testdata/deprecated"}}
+	)
+	require.NoError(t, cmd.run(&b, fixDeprecated))
+	require.Contains(t, b.String(), "-\t\tUnionDistinct(sql.Select(\"*\").From(sql.Table(\"pets\")))\n")
+	require.Contains(t, b.String(), "+\t\tUnion(sql.Select(\"*\").From(sql.Table(\"pets\")))\n")
+	require.Contains(t, b.String(), "-\treturn b.Nested(func(b *sql.Builder) {\n")
+	require.Contains(t, b.String(), "+\treturn b.Wrap(func(b *sql.Builder) {\n")
+}
+
+func TestDeprecated_Dump(t *testing.T) {
+	dir := t.TempDir()
+	write := writeFile(t, dir)
+	write("go.mod", "module example.com/app\n\ngo 1.23\n\nrequire entgo.io/ent v0.0.0\n\nreplace entgo.io/ent => ./ent\n")
+	// A minimal version of the schema package of ent.
+	write("ent/go.mod", "module entgo.io/ent\n\ngo 1.23\n")
+	write("ent/dialect/sql/schema/schema.go", `package schema
+
+import "context"
+
+type (
+	Table      struct{}
+	PlanOption func()
+	DDLArgs    struct {
+		Dialect, Version string
+		Tables           []*Table
+		Options          []PlanOption
+	}
+)
+
+func Dump(context.Context, string, string, []*Table, ...PlanOption) (string, error) { return "", nil }
+func DDL(context.Context, DDLArgs) (string, error)                                  { return "", nil }
+`)
+	write("app.go", `package app
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+func Dump(ctx context.Context, tables []*schema.Table, opts []schema.PlanOption) {
+	schema.Dump(ctx, "sqlite3", "3", tables)
+	schema.Dump(ctx, "postgres", "15", tables, opts...)
+	schema.Dump(ctx, "mysql", "8", tables, opts[0])
+}
+`)
+	var (
+		b   bytes.Buffer
+		cmd = &Codemod{Dir: dir, DryRun: true, Patterns: []string{"./..."}}
+	)
+	require.NoError(t, cmd.run(&b, fixDeprecated))
+	require.Contains(t, b.String(), "+\tschema.DDL(ctx, schema.DDLArgs{Dialect: \"sqlite3\", Version: \"3\", Tables: tables})\n")
+	require.Contains(t, b.String(), "+\tschema.DDL(ctx, schema.DDLArgs{Dialect: \"postgres\", Version: \"15\", Tables: tables, Options: opts})\n")
+	// Options that are passed one by one are skipped.
+	require.NotContains(t, b.String(), "-\tschema.Dump(ctx, \"mysql\"")
+
+	// Versions of ent without the DDL function are skipped.
+	write("ent/dialect/sql/schema/schema.go", `package schema
+
+import "context"
+
+type (
+	Table      struct{}
+	PlanOption func()
+)
+
+func Dump(context.Context, string, string, []*Table, ...PlanOption) (string, error) { return "", nil }
+`)
+	b.Reset()
+	require.NoError(t, cmd.run(&b, fixDeprecated))
+	require.Empty(t, b.String())
+}
+
+func TestModify(t *testing.T) {
+	var (
+		b   bytes.Buffer
+		cmd = &Codemod{DryRun: true, Patterns: []string{"./testdata/modify"}}
+	)
+	require.NoError(t, cmd.run(&b, fixModify))
+	require.Contains(t, b.String(), "+\treturn q.ForUpdate(sql.WithLockAction(sql.NoWait))\n")
+	require.Contains(t, b.String(), "-\tq.Modify(func(s *sql.Selector) { s.ForShare() })\n")
+	require.Contains(t, b.String(), "+\tq.ForShare()\n")
+	// Query builders without the lock methods are skipped.
+	require.NotContains(t, b.String(), "p.ForShare()")
+}
+
+func TestRename(t *testing.T) {
+	dir := t.TempDir()
+	write := writeFile(t, dir)
+	write("go.mod", "module example.com/app\n\ngo 1.23\n")
+	// A minimal version of the generated code.
+	write("ent/ent.go", `// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+type User struct{ Name string }
+
+type UserCreate struct{}
+
+func (c *UserCreate) SetName(string) *UserCreate { return c }
+func (c *UserCreate) SetNickname(string) *UserCreate { return c }
+
+type Group struct{ Name string }
+
+type GroupCreate struct{}
+
+func (c *GroupCreate) SetName(string) *GroupCreate { return c }
+
+type Pet struct{ Owner string }
+`)
+	write("ent/user/user.go", `// Code generated by ent, DO NOT EDIT.
+
+package user
+
+const FieldName = "name"
+
+func Name(string) func() { return nil }
+func NameHasPrefix(string) func() { return nil }
+`)
+	write("app.go", `package app
+
+import (
+	"example.com/app/ent"
+	"example.com/app/ent/user"
+)
+
+func Create(c *ent.UserCreate, g *ent.GroupCreate, u *ent.User) {
+	c.SetName(u.Name).SetNickname("a8m")
+	g.SetName("g")
+	_, _, _ = user.FieldName, user.Name("a8m"), user.NameHasPrefix("a")
+}
+
+func Owner(p *ent.Pet) string {
+	return p.Owner
+}
+`)
+	var (
+		b   bytes.Buffer
+		cmd = &Rename{
+			Codemod: Codemod{Dir: dir, Patterns: []string{"./..."}},
+			Package: "example.com/app/ent",
+			renames: []rename{
+				{Type: "User", From: "name", To: "full_name"},
+				{Type: "Pet", From: "owner", To: "owner_name"},
+			},
+		}
+	)
+	require.NoError(t, cmd.run(&b, cmd.fix))
+	require.Equal(t, "entfix: 1 files were changed\n", b.String())
+	buf, err := os.ReadFile(filepath.Join(dir, "app.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `c.SetFullName(u.FullName).SetNickname("a8m")`)
+	require.Contains(t, string(buf), `g.SetName("g")`)
+	require.Contains(t, string(buf), `user.FieldFullName, user.FullName("a8m"), user.FullNameHasPrefix("a")`)
+	require.Contains(t, string(buf), `return p.OwnerName`)
+	// Generated files are not changed.
+	buf, err = os.ReadFile(filepath.Join(dir, "ent", "ent.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "SetName(string)")
+}
+
+func TestRename_Load(t *testing.T) {
+	cmd := &Rename{Package: "example.com/app/ent", Type: "User", From: "name"}
+	require.EqualError(t, cmd.load(), "entfix: the --type, --from and --to flags must be set together")
+	cmd = &Rename{Type: "User", From: "name", To: "full_name"}
+	require.EqualError(t, cmd.load(), "entfix: missing --package or --schema flag")
+	cmd = &Rename{Package: "example.com/app/ent"}
+	require.EqualError(t, cmd.load(), "entfix: no renamed fields were found")
+	cmd = &Rename{Package: "example.com/app/ent", Type: "User", From: "name", To: "full_name"}
+	require.NoError(t, cmd.load())
+	require.Equal(t, []rename{{Type: "User", From: "name", To: "full_name"}}, cmd.renames)
+}
+
+func TestSchemaRenames(t *testing.T) {
+	renames, err := schemaRenames([]*load.Schema{
+		{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "age"},
+				{Name: "full_name", Annotations: map[string]any{renameAnnotation: map[string]any{"From": "name"}}},
+			},
+		},
+		{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "owner_name", Annotations: map[string]any{renameAnnotation: map[string]any{"From": "owner"}}},
+				// Annotations that were left after the rename are ignored.
+				{Name: "nickname", Annotations: map[string]any{renameAnnotation: map[string]any{"From": "nickname"}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []rename{
+		{Type: "User", From: "name", To: "full_name"},
+		{Type: "Pet", From: "owner", To: "owner_name"},
+	}, renames)
+
+	_, err = schemaRenames([]*load.Schema{
+		{Name: "User", Fields: []*load.Field{{Name: "name", Annotations: map[string]any{renameAnnotation: "name"}}}},
+	})
+	require.Error(t, err)
+}
+
+// writeFile returns a function that writes files relative to the given directory.
+func writeFile(t *testing.T, dir string) func(name, content string) {
+	return func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+}
@@ -23,7 +23,10 @@ import (
 type (
 	// App configures the entfix CLI.
 	App struct {
-		GlobalID GlobalID `cmd:"" name:"globalid" help:"Migrate unique global id ent_types to ent global feature"`
+		GlobalID   GlobalID   `cmd:"" name:"globalid" help:"Migrate unique global id ent_types to ent global feature"`
+		Deprecated Deprecated `cmd:"" name:"deprecated" help:"Rewrite the usage of deprecated ent APIs to their replacements"`
+		Rename     Rename     `cmd:"" name:"rename" help:"Rewrite the usage of the generated APIs of a renamed field"`
+		Modify     Modify     `cmd:"" name:"modify" help:"Replace Modify calls that lock rows with the ForUpdate and ForShare builder methods"`
 	}
 	// GlobalID represents the 'entfix globalid' command.
 	GlobalID struct {
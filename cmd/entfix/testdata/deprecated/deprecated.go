@@ -0,0 +1,17 @@
+package deprecated
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+func Union() *sql.Selector {
+	return sql.Select("*").
+		From(sql.Table("users")).
+		UnionDistinct(sql.Select("*").From(sql.Table("pets")))
+}
+
+func Nested(b *sql.Builder) *sql.Builder {
+	return b.Nested(func(b *sql.Builder) {
+		b.WriteString("1")
+	})
+}
@@ -0,0 +1,28 @@
+package modify
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserQuery mimics a query builder that was generated with the sql/lock feature.
+type UserQuery struct{}
+
+func (q *UserQuery) Modify(...func(*sql.Selector)) *UserQuery { return q }
+func (q *UserQuery) ForUpdate(...sql.LockOption) *UserQuery   { return q }
+func (q *UserQuery) ForShare(...sql.LockOption) *UserQuery    { return q }
+
+// PetQuery mimics a query builder that was generated without the sql/lock feature.
+type PetQuery struct{}
+
+func (q *PetQuery) Modify(...func(*sql.Selector)) *PetQuery { return q }
+
+func Lock(q *UserQuery) *UserQuery {
+	return q.Modify(func(s *sql.Selector) {
+		s.ForUpdate(sql.WithLockAction(sql.NoWait))
+	})
+}
+
+func Share(q *UserQuery, p *PetQuery) {
+	q.Modify(func(s *sql.Selector) { s.ForShare() })
+	p.Modify(func(s *sql.Selector) { s.ForShare() })
+}
@@ -7,10 +7,14 @@ package base
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -19,10 +23,13 @@ import (
 	"entgo.io/ent/cmd/internal/printer"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/diff"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/tools/imports"
 )
 
 // IDType is a custom ID implementation for pflag.
@@ -79,45 +86,77 @@ func InitCmd() *cobra.Command {
 
 // NewCmd returns the new command for ent/c packages.
 func NewCmd() *cobra.Command {
-	var target, tmplPath string
+	var (
+		target, tmplPath, policy string
+		edges, mixins            []string
+	)
 	cmd := &cobra.Command{
-		Use:   "new [flags] [schemas]",
+		Use:   "new [flags] [schemas [fields]]",
 		Short: "initialize a new environment with zero or more schemas",
+		Long: "Initialize a new environment with zero or more schemas. Each schema name can be followed by\n" +
+			"field specs in the format of name:type[:modifier...], and edges are added using the --edge flag\n" +
+			"in the format of [schema.]name:type:rel[:inverse]. Inverse edges are added to the referenced schemas.",
 		Example: examples(
 			"ent new Example",
 			"ent new --target entv1/schema User Group",
 			"ent new --template ./path/to/file.tmpl User",
+			"ent new User name:string:unique age:int:optional --edge pets:Pet:o2m",
+			"ent new Pet name:string:notempty 'status:enum(available,sold)' --edge owner:User:m2o:pets",
+			"ent new Group name:string --mixin time --policy readonly --edge users:User:m2m",
 		),
-		Args: func(_ *cobra.Command, names []string) error {
-			for _, name := range names {
-				if !unicode.IsUpper(rune(name[0])) {
+		Args: func(_ *cobra.Command, args []string) error {
+			for i, arg := range args {
+				switch {
+				case strings.Contains(arg, ":"):
+					if i == 0 {
+						return fmt.Errorf("field spec %q must follow a schema name", arg)
+					}
+				case !unicode.IsUpper(rune(arg[0])):
 					return errors.New("schema names must begin with uppercase")
 				}
 			}
 			return nil
 		},
-		Run: func(cmd *cobra.Command, names []string) {
+		Run: func(cmd *cobra.Command, args []string) {
 			var (
 				err  error
 				tmpl *template.Template
 			)
 			if tmplPath != "" {
-				tmpl = template.New(filepath.Base(tmplPath)).Funcs(gen.Funcs)
+				tmpl = template.New(filepath.Base(tmplPath)).Funcs(specFuncs())
 				tmpl, err = tmpl.ParseFiles(tmplPath)
 			} else {
-				tmpl = template.New("schema").Funcs(gen.Funcs)
+				tmpl = template.New("schema").Funcs(specFuncs())
 				tmpl, err = tmpl.Parse(defaultTemplate)
 			}
 			if err != nil {
 				log.Fatalln(fmt.Errorf("ent/new: could not parse template %w", err))
 			}
-			if err := newEnv(target, names, tmpl); err != nil {
+			schemas, inverses, err := parseSpecs(args, edges, mixins, policy)
+			if err != nil {
+				log.Fatalln(fmt.Errorf("ent/new: %w", err))
+			}
+			// Inverse edges are added to the existing schemas before
+			// writing the new schemas, to fail without partial changes.
+			edits := make(schemaEdits)
+			for _, e := range inverses {
+				if err := edits.addEdge(target, e.Type, e.Inverse, e.inverse); err != nil {
+					log.Fatalln(fmt.Errorf("ent/new: add inverse edge of %s.%s: %w", e.Schema, e.Name, err))
+				}
+			}
+			if err := newEnv(target, schemas, tmpl); err != nil {
+				log.Fatalln(fmt.Errorf("ent/new: %w", err))
+			}
+			if err := edits.write(); err != nil {
 				log.Fatalln(fmt.Errorf("ent/new: %w", err))
 			}
 		},
 	}
 	cmd.Flags().StringVar(&target, "target", defaultSchema, "target directory for schemas")
 	cmd.Flags().StringVar(&tmplPath, "template", "", "template to use for new schemas")
+	cmd.Flags().StringArrayVar(&edges, "edge", nil, "edges to add in the format of [schema.]name:type:rel[:inverse]")
+	cmd.Flags().StringSliceVar(&mixins, "mixin", nil, "mixin presets to add to new schemas (time, createtime or updatetime)")
+	cmd.Flags().StringVar(&policy, "policy", "", "policy preset to add to new schemas (allow, deny or readonly)")
 	return cmd
 }
 
@@ -146,6 +185,7 @@ func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 	var (
 		cfg       gen.Config
 		storage   string
+		watch     bool
 		features  []string
 		templates []string
 		idtype    = IDType(field.TypeInt)
@@ -155,6 +195,7 @@ func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 			Example: examples(
 				"ent generate ./ent/schema",
 				"ent generate github.com/a8m/x",
+				"ent generate --watch ./ent/schema",
 			),
 			Args: cobra.ExactArgs(1),
 			Run: func(cmd *cobra.Command, path []string) {
@@ -188,6 +229,22 @@ func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 					cfg.Package = pkgPath
 				}
 				cfg.IDType = &field.TypeInfo{Type: field.Type(idtype)}
+				if watch {
+					ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+					defer stop()
+					err := entc.Watch(ctx, path[0], &cfg, func(e *entc.WatchEvent) {
+						printWatch(cmd.OutOrStdout(), e)
+						if e.Err == nil {
+							for _, fn := range postRun {
+								fn(&cfg)
+							}
+						}
+					}, opts...)
+					if err != nil {
+						log.Fatalln(err)
+					}
+					return
+				}
 				if err := entc.Generate(path[0], &cfg, opts...); err != nil {
 					log.Fatalln(err)
 				}
@@ -203,6 +260,7 @@ func GenerateCmd(postRun ...func(*gen.Config)) *cobra.Command {
 	cmd.Flags().StringVar(&cfg.Target, "target", "", "target directory for codegen")
 	cmd.Flags().StringSliceVarP(&features, "feature", "", nil, "extend codegen with additional features")
 	cmd.Flags().StringSliceVarP(&templates, "template", "", nil, "external templates to execute")
+	cmd.Flags().BoolVar(&watch, "watch", false, "watch the schema directory and regenerate on changes")
 	// The --idtype flag predates the field.<Type>("id") option.
 	// See, https://entgo.io/docs/schema-fields#id-field.
 	cobra.CheckErr(cmd.Flags().MarkHidden("idtype"))
@@ -277,24 +335,157 @@ func SchemaCmd() *cobra.Command {
 	return cmd
 }
 
+// DiffCmd returns the diff command for ent/c packages.
+func DiffCmd() *cobra.Command {
+	var (
+		target, format string
+		cmd            = &cobra.Command{
+			Use:   "diff [flags] rev1 rev2 path",
+			Short: "print the changes of the schema between two git revisions",
+			Long: "Print the changes of the schema between two git revisions. Schemas are loaded\n" +
+				"from the snapshots that are generated with the \"schema/snapshot\" feature flag,\n" +
+				"and therefore, it must be enabled at both revisions.",
+			Example: examples(
+				"ent diff main HEAD ./ent/schema",
+				"ent diff --format json v0.1.0 v0.2.0 ./ent/schema",
+				"ent diff --target ./gen/ent HEAD~1 HEAD ./ent/schema",
+			),
+			Args: cobra.ExactArgs(3),
+			Run: func(cmd *cobra.Command, args []string) {
+				// If the target directory is not provided,
+				// it is inferred from the schema path.
+				if target == "" {
+					target = filepath.Dir(args[2])
+				}
+				path := filepath.Join(target, "internal", "schema.go")
+				old, err := snapshotAt(args[0], path)
+				if err != nil {
+					log.Fatalln(err)
+				}
+				new, err := snapshotAt(args[1], path)
+				if err != nil {
+					log.Fatalln(err)
+				}
+				changes := diff.Schemas(old, new)
+				switch format {
+				case "json":
+					err = printJSON(os.Stdout, args[0], args[1], changes)
+				case "text":
+					printChanges(os.Stdout, args[0], args[1], changes)
+				default:
+					err = fmt.Errorf("ent/diff: unknown format %q", format)
+				}
+				if err != nil {
+					log.Fatalln(err)
+				}
+			},
+		}
+	)
+	cmd.Flags().StringVar(&target, "target", "", "target directory of the generated code")
+	cmd.Flags().StringVar(&format, "format", "text", "output format (text or json)")
+	return cmd
+}
+
+// snapshotAt loads the schema snapshot in the given path at the given git revision.
+func snapshotAt(rev, path string) ([]*load.Schema, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "show", rev+":./"+filepath.ToSlash(filepath.Clean(path)))
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("ent/diff: read schema snapshot %s at %s (the \"schema/snapshot\" feature must be enabled at both revisions): %s", path, rev, strings.TrimSpace(stderr.String()))
+	}
+	schemas, err := diff.ReadSnapshot(path, out)
+	if err != nil {
+		return nil, fmt.Errorf("ent/diff: read schema snapshot %s at %s: %w", path, rev, err)
+	}
+	return schemas, nil
+}
+
+// printChanges prints the given changes in a human-readable format.
+func printChanges(w io.Writer, from, to string, changes diff.Changes) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "No schema changes between %s and %s\n", from, to)
+		return
+	}
+	var typ string
+	for _, c := range changes {
+		if c.Type != typ {
+			typ = c.Type
+			fmt.Fprintf(w, "%s:\n", typ)
+		}
+		sign := "~"
+		switch c.Kind {
+		case diff.Added:
+			sign = "+"
+		case diff.Removed:
+			sign = "-"
+		}
+		fmt.Fprintf(w, "  %s %s\n", sign, c)
+	}
+	var api, db, review int
+	for _, c := range changes {
+		if c.APIBreaking {
+			api++
+		}
+		if c.DBBreaking {
+			db++
+		}
+		if c.Review {
+			review++
+		}
+	}
+	fmt.Fprintf(w, "\n%d changes: %d api-breaking, %d db-breaking, %d to review\n", len(changes), api, db, review)
+}
+
+// printJSON prints the given changes in JSON format.
+func printJSON(w io.Writer, from, to string, changes diff.Changes) error {
+	if changes == nil {
+		changes = diff.Changes{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		From        string       `json:"from"`
+		To          string       `json:"to"`
+		APIBreaking bool         `json:"api_breaking"`
+		DBBreaking  bool         `json:"db_breaking"`
+		Review      bool         `json:"review"`
+		Changes     diff.Changes `json:"changes"`
+	}{
+		From:        from,
+		To:          to,
+		APIBreaking: changes.APIBreaking(),
+		DBBreaking:  changes.DBBreaking(),
+		Review:      changes.Review(),
+		Changes:     changes,
+	})
+}
+
 // newEnv create a new environment for ent codegen.
-func newEnv(target string, names []string, tmpl *template.Template) error {
+func newEnv(target string, schemas []*SchemaSpec, tmpl *template.Template) error {
 	if err := createDir(target); err != nil {
 		return fmt.Errorf("create dir %s: %w", target, err)
 	}
-	for _, name := range names {
-		if err := gen.ValidSchemaName(name); err != nil {
-			return fmt.Errorf("new schema %s: %w", name, err)
+	for _, s := range schemas {
+		if err := gen.ValidSchemaName(s.Name); err != nil {
+			return fmt.Errorf("new schema %s: %w", s.Name, err)
 		}
-		if fileExists(target, name) {
-			return fmt.Errorf("new schema %s: already exists", name)
+		if fileExists(target, s.Name) {
+			return fmt.Errorf("new schema %s: already exists", s.Name)
 		}
+	}
+	for _, s := range schemas {
 		b := bytes.NewBuffer(nil)
-		if err := tmpl.Execute(b, name); err != nil {
-			return fmt.Errorf("executing template %s: %w", name, err)
+		if err := tmpl.Execute(b, s); err != nil {
+			return fmt.Errorf("executing template %s: %w", s.Name, err)
+		}
+		newFileTarget := filepath.Join(target, strings.ToLower(s.Name+".go"))
+		buf, err := imports.Process(newFileTarget, b.Bytes(), nil)
+		if err != nil {
+			return fmt.Errorf("formatting schema %s: %w", s.Name, err)
 		}
-		newFileTarget := filepath.Join(target, strings.ToLower(name+".go"))
-		if err := os.WriteFile(newFileTarget, b.Bytes(), 0644); err != nil {
+		if err := os.WriteFile(newFileTarget, buf, 0644); err != nil {
 			return fmt.Errorf("writing file %s: %w", newFileTarget, err)
 		}
 	}
@@ -332,22 +523,64 @@ const (
 	// schema template for the "init" command.
 	defaultTemplate = `package schema
 
-import "entgo.io/ent"
+{{ with .Imports }}
+{{- if eq (len .) 1 }}
+import "{{ index . 0 }}"
+{{- else }}
+import (
+	{{- range . }}
+	"{{ . }}"
+	{{- end }}
+)
+{{- end }}
+{{- end }}
 
-// {{ . }} holds the schema definition for the {{ . }} entity.
-type {{ . }} struct {
+// {{ .Name }} holds the schema definition for the {{ .Name }} entity.
+type {{ .Name }} struct {
 	ent.Schema
 }
-
-// Fields of the {{ . }}.
-func ({{ . }}) Fields() []ent.Field {
+{{ with .Mixins }}
+// Mixin of the {{ $.Name }}.
+func ({{ $.Name }}) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+}
+{{ end }}
+// Fields of the {{ .Name }}.
+func ({{ .Name }}) Fields() []ent.Field {
+	{{- with .Fields }}
+	return []ent.Field{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+	{{- else }}
 	return nil
+	{{- end }}
 }
 
-// Edges of the {{ . }}.
-func ({{ . }}) Edges() []ent.Edge {
+// Edges of the {{ .Name }}.
+func ({{ .Name }}) Edges() []ent.Edge {
+	{{- with .Edges }}
+	return []ent.Edge{
+		{{- range . }}
+		{{ . }},
+		{{- end }}
+	}
+	{{- else }}
 	return nil
+	{{- end }}
+}
+{{- with .Policy }}
+
+// Policy of the {{ $.Name }}.
+func ({{ $.Name }}) Policy() ent.Policy {
+	return {{ . }}
 }
+{{- end }}
 `
 )
 
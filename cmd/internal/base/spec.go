@@ -0,0 +1,567 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package base
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"entgo.io/ent/entc/gen"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// SchemaSpec describes a schema that is created by the "new" command. It is
+// the data of the schema templates, and it prints as the name of the schema.
+type SchemaSpec struct {
+	// Name of the schema.
+	Name string
+	// Fields, Edges and Mixins hold the Go expressions of the
+	// schema fields, edges and mixins. For example:
+	//
+	//	field.String("name").Unique()
+	//
+	Fields, Edges, Mixins []string
+	// Policy holds the Go expression of the schema policy, if any.
+	Policy string
+}
+
+// String returns the name of the schema.
+func (s *SchemaSpec) String() string { return s.Name }
+
+// specFuncs returns the functions of the schema templates. Templates were executed
+// with the schema name before they were executed with its spec. Hence, functions
+// that accept strings (e.g. lower) also accept the spec, and receive its name.
+func specFuncs() template.FuncMap {
+	var (
+		funcs = make(template.FuncMap, len(gen.Funcs))
+		str   = reflect.TypeOf("")
+		anyT  = reflect.TypeOf((*any)(nil)).Elem()
+	)
+	for name, f := range gen.Funcs {
+		fn := reflect.ValueOf(f)
+		t := fn.Type()
+		in := make([]reflect.Type, t.NumIn())
+		out := make([]reflect.Type, t.NumOut())
+		var wrap bool
+		for i := range in {
+			switch in[i] = t.In(i); {
+			case in[i] == str:
+				in[i], wrap = anyT, true
+			case t.IsVariadic() && i == len(in)-1 && in[i].Elem() == str:
+				in[i], wrap = reflect.SliceOf(anyT), true
+			}
+		}
+		if !wrap {
+			funcs[name] = f
+			continue
+		}
+		for i := range out {
+			out[i] = t.Out(i)
+		}
+		funcs[name] = reflect.MakeFunc(reflect.FuncOf(in, out, t.IsVariadic()), func(args []reflect.Value) []reflect.Value {
+			for i := range args {
+				switch {
+				case t.In(i) == str:
+					args[i] = specArg(args[i])
+				case in[i].Kind() == reflect.Slice && in[i].Elem() == anyT:
+					vs := reflect.MakeSlice(t.In(i), args[i].Len(), args[i].Len())
+					for j := range args[i].Len() {
+						vs.Index(j).Set(specArg(args[i].Index(j)))
+					}
+					args[i] = vs
+				}
+			}
+			if t.IsVariadic() {
+				return fn.CallSlice(args)
+			}
+			return fn.Call(args)
+		}).Interface()
+	}
+	return funcs
+}
+
+// specArg returns the string value of a template function argument. Panics
+// are reported by the template engine as errors of the function call.
+func specArg(v reflect.Value) reflect.Value {
+	switch x := v.Interface().(type) {
+	case string:
+		return reflect.ValueOf(x)
+	case *SchemaSpec:
+		return reflect.ValueOf(x.Name)
+	default:
+		panic(fmt.Sprintf("wrong type for value; expected string; got %T", x))
+	}
+}
+
+// Imports returns the import paths used by the schema expressions.
+func (s *SchemaSpec) Imports() []string {
+	imports := []string{"entgo.io/ent"}
+	for pkg, path := range map[string]string{
+		"edge.":    "entgo.io/ent/schema/edge",
+		"field.":   "entgo.io/ent/schema/field",
+		"mixin.":   "entgo.io/ent/schema/mixin",
+		"privacy.": "entgo.io/ent/privacy",
+		"time.":    "time",
+		"uuid.":    "github.com/google/uuid",
+	} {
+		if slices.ContainsFunc(slices.Concat(s.Fields, s.Edges, s.Mixins, []string{s.Policy}), func(x string) bool {
+			return strings.Contains(x, pkg)
+		}) {
+			imports = append(imports, path)
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+var (
+	// fieldTypes maps the types of field specs to their field constructors.
+	fieldTypes = map[string]string{
+		"bool":    "Bool",
+		"bytes":   "Bytes",
+		"float":   "Float",
+		"float32": "Float32",
+		"int":     "Int",
+		"int8":    "Int8",
+		"int16":   "Int16",
+		"int32":   "Int32",
+		"int64":   "Int64",
+		"json":    "JSON",
+		"string":  "String",
+		"text":    "Text",
+		"time":    "Time",
+		"uint":    "Uint",
+		"uint8":   "Uint8",
+		"uint16":  "Uint16",
+		"uint32":  "Uint32",
+		"uint64":  "Uint64",
+		"uuid":    "UUID",
+	}
+	// fieldModifiers maps the modifiers of field specs to their builder methods.
+	fieldModifiers = map[string]string{
+		"immutable": "Immutable()",
+		"nillable":  "Nillable()",
+		"notempty":  "NotEmpty()",
+		"optional":  "Optional()",
+		"positive":  "Positive()",
+		"sensitive": "Sensitive()",
+		"unique":    "Unique()",
+	}
+	// mixinPresets maps the mixin presets to their Go expressions.
+	mixinPresets = map[string]string{
+		"createtime": "mixin.CreateTime{}",
+		"time":       "mixin.Time{}",
+		"updatetime": "mixin.UpdateTime{}",
+	}
+	// policyPresets maps the policy presets to their Go expressions.
+	policyPresets = map[string]string{
+		"allow":    policyExpr("privacy.AlwaysAllowRule()", "privacy.AlwaysAllowRule()"),
+		"deny":     policyExpr("privacy.AlwaysDenyRule()", "privacy.AlwaysDenyRule()"),
+		"readonly": policyExpr("privacy.AlwaysAllowRule()", "privacy.AlwaysDenyRule()"),
+	}
+)
+
+func policyExpr(query, mutation string) string {
+	return fmt.Sprintf("privacy.Policy{\nQuery: privacy.QueryPolicy{\n%s,\n},\nMutation: privacy.MutationPolicy{\n%s,\n},\n}", query, mutation)
+}
+
+// parseField parses a field spec in the format of "name:type[:modifier...]".
+// For example, "name:string:unique", "age:int:optional:default=1" or
+// "status:enum(active,inactive)".
+func parseField(spec string) (string, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || parts[0] == "" {
+		return "", fmt.Errorf("invalid field spec %q, expect name:type[:modifier...]", spec)
+	}
+	name, typ := parts[0], parts[1]
+	var b strings.Builder
+	switch values, ok := strings.CutPrefix(typ, "enum("); {
+	case ok && strings.HasSuffix(values, ")"):
+		typ = "enum"
+		fmt.Fprintf(&b, "field.Enum(%q).Values(", name)
+		for i, v := range strings.Split(strings.TrimSuffix(values, ")"), ",") {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(strconv.Quote(v))
+		}
+		b.WriteString(")")
+	case typ == "uuid":
+		fmt.Fprintf(&b, "field.UUID(%q, uuid.UUID{})", name)
+	case typ == "json":
+		fmt.Fprintf(&b, "field.JSON(%q, map[string]any{})", name)
+	case fieldTypes[typ] != "":
+		fmt.Fprintf(&b, "field.%s(%q)", fieldTypes[typ], name)
+	default:
+		return "", fmt.Errorf("field %q: unknown type %q", name, typ)
+	}
+	for _, m := range parts[2:] {
+		if v, ok := strings.CutPrefix(m, "default="); ok {
+			d, err := defaultExpr(typ, v)
+			if err != nil {
+				return "", fmt.Errorf("field %q: %w", name, err)
+			}
+			fmt.Fprintf(&b, ".Default(%s)", d)
+			continue
+		}
+		method, ok := fieldModifiers[m]
+		switch {
+		case !ok:
+			return "", fmt.Errorf("field %q: unknown modifier %q", name, m)
+		case m == "notempty" && typ != "string" && typ != "text" && typ != "bytes":
+			return "", fmt.Errorf("field %q: modifier %q is not supported by type %q", name, m, typ)
+		case m == "positive" && !isNumeric(typ):
+			return "", fmt.Errorf("field %q: modifier %q is not supported by type %q", name, m, typ)
+		}
+		b.WriteString("." + method)
+	}
+	return b.String(), nil
+}
+
+// defaultExpr returns the Go expression of the default value of a field.
+func defaultExpr(typ, v string) (string, error) {
+	var err error
+	switch {
+	case typ == "string", typ == "text", typ == "enum":
+		return strconv.Quote(v), nil
+	case typ == "bool":
+		_, err = strconv.ParseBool(v)
+	case typ == "time" && v == "now":
+		return "time.Now", nil
+	case typ == "uuid" && v == "new":
+		return "uuid.New", nil
+	case isNumeric(typ):
+		_, err = strconv.ParseFloat(v, 64)
+	default:
+		err = errors.New("default value is not supported")
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid default value %q for type %q: %w", v, typ, err)
+	}
+	return v, nil
+}
+
+func isNumeric(typ string) bool {
+	return strings.HasPrefix(typ, "int") || strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "float")
+}
+
+// edgeSpec describes an edge that was parsed from an edge spec.
+type edgeSpec struct {
+	Schema  string // Schema that holds the edge.
+	Name    string // Name of the edge.
+	Type    string // Type of the edge.
+	Rel     string // Relation type of the edge (o2o, o2m, m2o or m2m).
+	Inverse string // Name of the inverse edge (in Type).
+	inverse string // Go expression of the inverse edge.
+}
+
+// parseEdge parses an edge spec in the format of "[schema.]name:type:rel[:inverse]".
+// For example, "pets:Pet:o2m" or "User.pets:Pet:o2m:owner". The schema can be omitted
+// if only one schema is created, and the inverse name is inferred from the schema name.
+func parseEdge(spec string, schemas []*SchemaSpec) (*edgeSpec, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 3 || len(parts) > 4 {
+		return nil, fmt.Errorf("invalid edge spec %q, expect [schema.]name:type:rel[:inverse]", spec)
+	}
+	e := &edgeSpec{Name: parts[0], Type: parts[1], Rel: strings.ToLower(parts[2])}
+	if len(parts) == 4 {
+		e.Inverse = parts[3]
+	}
+	switch schema, name, ok := strings.Cut(e.Name, "."); {
+	case ok:
+		e.Schema, e.Name = schema, name
+	case len(schemas) == 1:
+		e.Schema = schemas[0].Name
+	default:
+		return nil, fmt.Errorf("edge spec %q must be prefixed with its schema name (e.g. User.%s)", spec, spec)
+	}
+	switch {
+	case e.Name == "":
+		return nil, fmt.Errorf("invalid edge spec %q: missing edge name", spec)
+	case !slices.ContainsFunc(schemas, func(s *SchemaSpec) bool { return s.Name == e.Schema }):
+		return nil, fmt.Errorf("edge %q: schema %q is not created by this command", e.Name, e.Schema)
+	case e.Type == "" || !unicode.IsUpper(rune(e.Type[0])):
+		return nil, fmt.Errorf("edge %q: type %q must begin with uppercase", e.Name, e.Type)
+	case !slices.Contains([]string{"o2o", "o2m", "m2o", "m2m"}, e.Rel):
+		return nil, fmt.Errorf("edge %q: unknown relation %q, expect o2o, o2m, m2o or m2m", e.Name, e.Rel)
+	case e.Schema == e.Type && (e.Rel == "o2m" || e.Rel == "m2o") && e.Inverse == "":
+		return nil, fmt.Errorf("edge %q: %s self-reference requires an inverse edge name", e.Name, e.Rel)
+	}
+	// Self-references without inverse names are bidirectional.
+	if e.Inverse == "" && e.Schema != e.Type {
+		// O2M edges are inverted by unique edges, M2O edges by non-unique
+		// edges, and O2O and M2M edges keep the cardinality of the edge.
+		e.Inverse = gen.Funcs["snake"].(func(string) string)(e.Schema)
+		if e.Rel == "m2o" || e.Rel == "m2m" {
+			e.Inverse = gen.Funcs["plural"].(func(string) string)(e.Inverse)
+		}
+	}
+	return e, nil
+}
+
+// Exprs returns the Go expression of the edge, and the expression of its inverse edge
+// that should be added to the referenced schema. The inverse is empty for self-references.
+func (e *edgeSpec) Exprs() (edge string, inverse string) {
+	unique := ""
+	if e.Rel == "o2o" || e.Rel == "m2o" {
+		unique = ".Unique()"
+	}
+	switch {
+	case e.Schema == e.Type && e.Inverse == "":
+		return fmt.Sprintf("edge.To(%q, %s.Type)%s", e.Name, e.Type, unique), ""
+	case e.Schema == e.Type && e.Rel == "m2o":
+		return fmt.Sprintf("edge.To(%q, %s.Type).From(%q).Unique()", e.Inverse, e.Type, e.Name), ""
+	case e.Schema == e.Type:
+		inverseUnique := ""
+		if e.Rel != "m2m" {
+			inverseUnique = ".Unique()"
+		}
+		return fmt.Sprintf("edge.To(%q, %s.Type)%s.From(%q)%s", e.Name, e.Type, unique, e.Inverse, inverseUnique), ""
+	case e.Rel == "m2o":
+		return fmt.Sprintf("edge.From(%q, %s.Type).Ref(%q).Unique()", e.Name, e.Type, e.Inverse),
+			fmt.Sprintf("edge.To(%q, %s.Type)", e.Inverse, e.Schema)
+	default:
+		inverseUnique := ".Unique()"
+		if e.Rel == "m2m" {
+			inverseUnique = ""
+		}
+		return fmt.Sprintf("edge.To(%q, %s.Type)%s", e.Name, e.Type, unique),
+			fmt.Sprintf("edge.From(%q, %s.Type).Ref(%q)%s", e.Inverse, e.Schema, e.Name, inverseUnique)
+	}
+}
+
+// schemaEdits holds the pending changes of existing schema files, keyed by their paths.
+type schemaEdits map[string][]byte
+
+// addEdge adds the given edge expression to the Edges method of
+// the schema typ that is defined in one of the files in target.
+func (s schemaEdits) addEdge(target, typ, name, expr string) error {
+	path, err := schemaFile(target, typ)
+	if err != nil {
+		return err
+	}
+	src, ok := s[path]
+	if !ok {
+		if src, err = os.ReadFile(path); err != nil {
+			return err
+		}
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	var (
+		fn  *ast.FuncDecl
+		off = func(p token.Pos) int { return fset.Position(p).Offset }
+	)
+	for _, d := range f.Decls {
+		if d, ok := d.(*ast.FuncDecl); ok && d.Name.Name == "Edges" && d.Recv != nil && len(d.Recv.List) == 1 && recvName(d.Recv.List[0].Type) == typ {
+			fn = d
+		}
+	}
+	var edit func() []byte
+	switch ret := lastReturn(fn); {
+	case fn == nil:
+		edit = func() []byte {
+			return fmt.Appendf(bytes.Clone(src), "\n// Edges of the %s.\nfunc (%s) Edges() []ent.Edge {\nreturn []ent.Edge{\n%s,\n}\n}\n", typ, typ, expr)
+		}
+	case ret == nil:
+		return fmt.Errorf("schema %s: missing return statement in Edges method", typ)
+	case isNil(ret.Results[0]):
+		nilx := ret.Results[0]
+		edit = func() []byte {
+			return slices.Concat(src[:off(nilx.Pos())], fmt.Appendf(nil, "[]ent.Edge{\n%s,\n}", expr), src[off(nilx.End()):])
+		}
+	default:
+		lit, ok := ret.Results[0].(*ast.CompositeLit)
+		if !ok {
+			return fmt.Errorf("schema %s: unexpected return statement in Edges method, expect nil or []ent.Edge{...}", typ)
+		}
+		if hasEdge(lit, name) {
+			return fmt.Errorf("schema %s: edge %q already exists", typ, name)
+		}
+		at, text := off(lit.Rbrace), expr+",\n"
+		if n := len(lit.Elts); n == 0 {
+			text = "\n" + text
+		} else if end := off(lit.Elts[n-1].End()); !bytes.Contains(src[end:at], []byte(",")) {
+			// The last element is not followed by a comma (e.g. single-line lists).
+			at, text = end, ",\n"+text
+		}
+		edit = func() []byte {
+			return slices.Concat(src[:at], []byte(text), src[at:])
+		}
+	}
+	src = edit()
+	fset = token.NewFileSet()
+	if f, err = parser.ParseFile(fset, path, src, parser.ParseComments); err != nil {
+		return fmt.Errorf("schema %s: add edge %q: %w", typ, name, err)
+	}
+	astutil.AddImport(fset, f, "entgo.io/ent/schema/edge")
+	var b bytes.Buffer
+	if err := format.Node(&b, fset, f); err != nil {
+		return fmt.Errorf("schema %s: format file: %w", typ, err)
+	}
+	s[path] = b.Bytes()
+	return nil
+}
+
+// write writes the changed schema files.
+func (s schemaEdits) write() error {
+	for path, buf := range s {
+		if err := os.WriteFile(path, buf, 0644); err != nil {
+			return fmt.Errorf("writing file %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// schemaFile returns the path of the file in target that defines the schema typ.
+func schemaFile(target, typ string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(target, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	// Start with the default file name of the schema.
+	slices.SortStableFunc(paths, func(a, _ string) int {
+		if filepath.Base(a) == strings.ToLower(typ+".go") {
+			return -1
+		}
+		return 0
+	})
+	for _, path := range paths {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", err
+		}
+		for _, d := range f.Decls {
+			if d, ok := d.(*ast.GenDecl); ok && d.Tok == token.TYPE && slices.ContainsFunc(d.Specs, func(s ast.Spec) bool {
+				return s.(*ast.TypeSpec).Name.Name == typ
+			}) {
+				return path, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("schema %s was not found in %s", typ, target)
+}
+
+// recvName returns the type name of a method receiver.
+func recvName(x ast.Expr) string {
+	if s, ok := x.(*ast.StarExpr); ok {
+		x = s.X
+	}
+	if id, ok := x.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// lastReturn returns the last return statement of the function body.
+func lastReturn(fn *ast.FuncDecl) *ast.ReturnStmt {
+	if fn == nil || fn.Body == nil || len(fn.Body.List) == 0 {
+		return nil
+	}
+	ret, ok := fn.Body.List[len(fn.Body.List)-1].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil
+	}
+	return ret
+}
+
+func isNil(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && id.Name == "nil"
+}
+
+// hasEdge reports if the given list of edges contains an edge with the given name.
+func hasEdge(lit *ast.CompositeLit, name string) bool {
+	found := false
+	ast.Inspect(lit, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return !found
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && (sel.Sel.Name == "To" || sel.Sel.Name == "From") {
+			if arg, ok := call.Args[0].(*ast.BasicLit); ok && arg.Value == strconv.Quote(name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// parseSpecs parses the arguments and the flags of the "new" command. It returns the schemas to
+// create, and the edges whose inverse edges should be added to existing schema files.
+func parseSpecs(args, edges, mixins []string, policy string) ([]*SchemaSpec, []*edgeSpec, error) {
+	var schemas []*SchemaSpec
+	for _, arg := range args {
+		if !strings.Contains(arg, ":") {
+			schemas = append(schemas, &SchemaSpec{Name: arg})
+			continue
+		}
+		f, err := parseField(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		s := schemas[len(schemas)-1]
+		s.Fields = append(s.Fields, f)
+	}
+	for _, name := range mixins {
+		m, ok := mixinPresets[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown mixin preset %q", name)
+		}
+		for _, s := range schemas {
+			s.Mixins = append(s.Mixins, m)
+		}
+	}
+	if policy != "" {
+		p, ok := policyPresets[policy]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown policy preset %q", policy)
+		}
+		for _, s := range schemas {
+			s.Policy = p
+		}
+	}
+	var inverses []*edgeSpec
+	for _, spec := range edges {
+		e, err := parseEdge(spec, schemas)
+		if err != nil {
+			return nil, nil, err
+		}
+		edge, inverse := e.Exprs()
+		idx := slices.IndexFunc(schemas, func(s *SchemaSpec) bool { return s.Name == e.Schema })
+		schemas[idx].Edges = append(schemas[idx].Edges, edge)
+		if inverse == "" {
+			continue
+		}
+		// Inverse edges of schemas that are created by this command are
+		// added to their specs, and to the existing schema files otherwise.
+		if idx := slices.IndexFunc(schemas, func(s *SchemaSpec) bool { return s.Name == e.Type }); idx != -1 {
+			schemas[idx].Edges = append(schemas[idx].Edges, inverse)
+		} else {
+			e.inverse = inverse
+			inverses = append(inverses, e)
+		}
+	}
+	return schemas, inverses, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package base
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseField(t *testing.T) {
+	for spec, expr := range map[string]string{
+		"name:string":                     `field.String("name")`,
+		"name:string:unique:notempty":     `field.String("name").Unique().NotEmpty()`,
+		"age:int:optional:default=1":      `field.Int("age").Optional().Default(1)`,
+		"id:uuid:default=new":             `field.UUID("id", uuid.UUID{}).Default(uuid.New)`,
+		"created_at:time:default=now":     `field.Time("created_at").Default(time.Now)`,
+		"status:enum(a,b):default=a":      `field.Enum("status").Values("a", "b").Default("a")`,
+		"data:json:optional":              `field.JSON("data", map[string]any{}).Optional()`,
+		"rate:float:positive:default=0.5": `field.Float("rate").Positive().Default(0.5)`,
+	} {
+		got, err := parseField(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, expr, got, spec)
+	}
+	for _, spec := range []string{"name", "name:unknown", "name:string:unknown", "age:int:notempty", "name:string:positive", "ok:bool:default=1.5", "at:time:default=1"} {
+		_, err := parseField(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestParseEdge(t *testing.T) {
+	schemas := []*SchemaSpec{{Name: "User"}}
+	for spec, exprs := range map[string][2]string{
+		"pets:Pet:o2m":                      {`edge.To("pets", Pet.Type)`, `edge.From("user", User.Type).Ref("pets").Unique()`},
+		"pets:Pet:o2m:owner":                {`edge.To("pets", Pet.Type)`, `edge.From("owner", User.Type).Ref("pets").Unique()`},
+		"card:Card:o2o":                     {`edge.To("card", Card.Type).Unique()`, `edge.From("user", User.Type).Ref("card").Unique()`},
+		"groups:Group:m2m":                  {`edge.To("groups", Group.Type)`, `edge.From("users", User.Type).Ref("groups")`},
+		"team:Team:m2o":                     {`edge.From("team", Team.Type).Ref("users").Unique()`, `edge.To("users", User.Type)`},
+		"friends:User:m2m":                  {`edge.To("friends", User.Type)`, ""},
+		"spouse:User:o2o":                   {`edge.To("spouse", User.Type).Unique()`, ""},
+		"children:User:o2m:parent":          {`edge.To("children", User.Type).From("parent").Unique()`, ""},
+		"parent:User:m2o:children":          {`edge.To("children", User.Type).From("parent").Unique()`, ""},
+		"User.following:User:m2m:followers": {`edge.To("following", User.Type).From("followers")`, ""},
+	} {
+		e, err := parseEdge(spec, schemas)
+		require.NoError(t, err, spec)
+		edge, inverse := e.Exprs()
+		require.Equal(t, exprs, [2]string{edge, inverse}, spec)
+	}
+	for _, spec := range []string{"pets:Pet", "pets:pet:o2m", "pets:Pet:x2y", "Group.pets:Pet:o2m", "children:User:o2m"} {
+		_, err := parseEdge(spec, schemas)
+		require.Error(t, err, spec)
+	}
+	_, err := parseEdge("pets:Pet:o2m", append(schemas, &SchemaSpec{Name: "Group"}))
+	require.Error(t, err, "schema name is required for multiple schemas")
+}
+
+func TestParseSpecs(t *testing.T) {
+	schemas, inverses, err := parseSpecs(
+		[]string{"User", "name:string", "Pet", "age:int"},
+		[]string{"User.pets:Pet:o2m:owner", "Pet.toys:Toy:o2m"},
+		[]string{"time"},
+		"deny",
+	)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.Equal(t, []string{`field.String("name")`}, schemas[0].Fields)
+	require.Equal(t, []string{`field.Int("age")`}, schemas[1].Fields)
+	require.Equal(t, []string{`edge.To("pets", Pet.Type)`}, schemas[0].Edges)
+	require.Equal(t, []string{`edge.From("owner", User.Type).Ref("pets").Unique()`, `edge.To("toys", Toy.Type)`}, schemas[1].Edges)
+	require.Equal(t, []string{"mixin.Time{}"}, schemas[0].Mixins)
+	require.Equal(t, policyPresets["deny"], schemas[1].Policy)
+	require.Equal(t, []string{"entgo.io/ent", "entgo.io/ent/privacy", "entgo.io/ent/schema/edge", "entgo.io/ent/schema/field", "entgo.io/ent/schema/mixin"}, schemas[0].Imports())
+	require.Len(t, inverses, 1)
+	require.Equal(t, `edge.From("pet", Pet.Type).Ref("toys").Unique()`, inverses[0].inverse)
+
+	_, _, err = parseSpecs([]string{"User"}, nil, []string{"unknown"}, "")
+	require.Error(t, err)
+	_, _, err = parseSpecs([]string{"User"}, nil, nil, "unknown")
+	require.Error(t, err)
+}
+
+func TestNewEnv(t *testing.T) {
+	target := t.TempDir()
+	tmpl := template.Must(template.New("schema").Parse(defaultTemplate))
+	schemas, _, err := parseSpecs([]string{"User", "name:string:unique"}, []string{"pets:Pet:o2m"}, []string{"time"}, "")
+	require.NoError(t, err)
+	require.NoError(t, newEnv(target, schemas, tmpl))
+	buf, err := os.ReadFile(filepath.Join(target, "user.go"))
+	require.NoError(t, err)
+	require.Equal(t, `package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pets", Pet.Type),
+	}
+}
+`, string(buf))
+	require.Error(t, newEnv(target, schemas, tmpl), "schema already exists")
+}
+
+func TestNewEnv_Funcs(t *testing.T) {
+	target := t.TempDir()
+	// Templates that were written for the schema name.
+	tmpl := template.Must(template.New("schema").Funcs(specFuncs()).Parse(`package schema
+
+// {{ lower . }}, {{ pascal .Name }}, {{ join (slist (snake .) "x") "," }}, {{ printf "%s" . }}
+type {{ . }} struct{}
+`))
+	require.NoError(t, newEnv(target, []*SchemaSpec{{Name: "GroupInfo"}}, tmpl))
+	buf, err := os.ReadFile(filepath.Join(target, "groupinfo.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package schema\n\n// groupinfo, GroupInfo, group_info,x, GroupInfo\ntype GroupInfo struct{}\n", string(buf))
+
+	tmpl = template.Must(template.New("schema").Funcs(specFuncs()).Parse(`{{ lower 1 }}`))
+	require.ErrorContains(t, newEnv(target, []*SchemaSpec{{Name: "User"}}, tmpl), "wrong type for value; expected string; got int")
+}
+
+func TestSchemaEdits(t *testing.T) {
+	target := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(target, name), []byte(content), 0644))
+	}
+	write("pet.go", `package schema
+
+import "entgo.io/ent"
+
+// Pet holds the schema definition for the Pet entity.
+type Pet struct {
+	ent.Schema
+}
+
+// Edges of the Pet.
+func (Pet) Edges() []ent.Edge {
+	return nil
+}
+`)
+	write("entities.go", `package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+)
+
+type Car struct {
+	ent.Schema
+}
+
+func (Car) Edges() []ent.Edge {
+	return []ent.Edge{edge.To("wheels", Wheel.Type)}
+}
+
+type Group struct {
+	ent.Schema
+}
+`)
+	edits := make(schemaEdits)
+	require.NoError(t, edits.addEdge(target, "Pet", "owner", `edge.From("owner", User.Type).Ref("pets").Unique()`))
+	require.NoError(t, edits.addEdge(target, "Pet", "toys", `edge.To("toys", Toy.Type)`))
+	require.NoError(t, edits.addEdge(target, "Car", "owner", `edge.From("owner", User.Type).Ref("cars").Unique()`))
+	require.NoError(t, edits.addEdge(target, "Group", "users", `edge.To("users", User.Type)`))
+	require.EqualError(t, edits.addEdge(target, "Car", "wheels", `edge.To("wheels", Wheel.Type)`), `schema Car: edge "wheels" already exists`)
+	require.Error(t, edits.addEdge(target, "Unknown", "users", `edge.To("users", User.Type)`))
+
+	// Files are not changed before calling write.
+	buf, err := os.ReadFile(filepath.Join(target, "pet.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "return nil")
+	require.NoError(t, edits.write())
+
+	buf, err = os.ReadFile(filepath.Join(target, "pet.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+)`)
+	require.Contains(t, string(buf), `func (Pet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).Ref("pets").Unique(),
+		edge.To("toys", Toy.Type),
+	}
+}`)
+	buf, err = os.ReadFile(filepath.Join(target, "entities.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `	return []ent.Edge{edge.To("wheels", Wheel.Type),
+		edge.From("owner", User.Type).Ref("cars").Unique(),
+	}`)
+	require.Contains(t, string(buf), `// Edges of the Group.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+	}
+}`)
+}
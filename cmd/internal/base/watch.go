@@ -0,0 +1,45 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package base
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc"
+)
+
+// printWatch prints the result of a codegen run in watch mode.
+func printWatch(w io.Writer, e *entc.WatchEvent) {
+	wd, _ := os.Getwd()
+	for _, f := range e.Files {
+		if rel, err := filepath.Rel(wd, f); err == nil && !strings.HasPrefix(rel, "..") {
+			f = rel
+		}
+		fmt.Fprintf(w, "Schema file changed: %s\n", f)
+	}
+	elapsed := e.Elapsed.Round(time.Millisecond)
+	switch {
+	case e.Recovered:
+		fmt.Fprintln(w, "Schema package failed to build, generated package was restored from the snapshot:")
+		fmt.Fprintf(w, "  -- %s\n", strings.ReplaceAll(e.Err.Error(), "\n", "\n     "))
+	case e.Reverted:
+		fmt.Fprintln(w, "Code generation failed, generated package was reverted to the last successful run:")
+		fmt.Fprintf(w, "  -- %s\n", strings.ReplaceAll(e.Err.Error(), "\n", "\n     "))
+	case e.Err != nil:
+		fmt.Fprintln(w, "Code generation failed:")
+		fmt.Fprintf(w, "  -- %s\n", strings.ReplaceAll(e.Err.Error(), "\n", "\n     "))
+	case e.Nodes == nil:
+		fmt.Fprintf(w, "Generated all nodes in %s\n", elapsed)
+	case len(e.Nodes) == 0:
+		fmt.Fprintln(w, "No schema changes, nothing to generate")
+	default:
+		fmt.Fprintf(w, "Generated %s in %s\n", strings.Join(e.Nodes, ", "), elapsed)
+	}
+}
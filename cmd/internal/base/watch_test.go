@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package base
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintWatch(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	var b bytes.Buffer
+	printWatch(&b, &entc.WatchEvent{Elapsed: 1200 * time.Millisecond})
+	require.Equal(t, "Generated all nodes in 1.2s\n", b.String())
+
+	b.Reset()
+	printWatch(&b, &entc.WatchEvent{
+		Files:   []string{filepath.Join(wd, "schema", "user.go")},
+		Nodes:   []string{"Pet", "User"},
+		Elapsed: 350 * time.Millisecond,
+	})
+	require.Equal(t, "Schema file changed: schema/user.go\nGenerated Pet, User in 350ms\n", b.String())
+
+	b.Reset()
+	printWatch(&b, &entc.WatchEvent{Files: []string{"/tmp/user.go"}, Nodes: []string{}})
+	require.Equal(t, "Schema file changed: /tmp/user.go\nNo schema changes, nothing to generate\n", b.String())
+
+	b.Reset()
+	printWatch(&b, &entc.WatchEvent{Recovered: true, Err: errors.New("user.go:10:2: undefined: x\nuser.go:11:2: undefined: y")})
+	require.Equal(t, `Schema package failed to build, generated package was restored from the snapshot:
+  -- user.go:10:2: undefined: x
+     user.go:11:2: undefined: y
+`, b.String())
+
+	b.Reset()
+	printWatch(&b, &entc.WatchEvent{Reverted: true, Err: errors.New("template: execution failed")})
+	require.Equal(t, "Code generation failed, generated package was reverted to the last successful run:\n  -- template: execution failed\n", b.String())
+
+	b.Reset()
+	printWatch(&b, &entc.WatchEvent{Err: errors.New("edge schema not found")})
+	require.Equal(t, "Code generation failed:\n  -- edge schema not found\n", b.String())
+}
@@ -8,8 +8,10 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -123,6 +125,30 @@ func (d *DebugDriver) QueryContext(ctx context.Context, query string, args ...an
 	return drv.QueryContext(ctx, query, args...)
 }
 
+// Lock logs its params and calls the underlying driver Lock method if it is supported.
+func (d *DebugDriver) Lock(ctx context.Context, key string) (func() error, error) {
+	drv, ok := d.Driver.(interface {
+		Lock(context.Context, string) (func() error, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.Lock is not supported")
+	}
+	d.log(ctx, fmt.Sprintf("driver.Lock: key=%v", key))
+	return drv.Lock(ctx, key)
+}
+
+// TryLock logs its params and calls the underlying driver TryLock method if it is supported.
+func (d *DebugDriver) TryLock(ctx context.Context, key string) (func() error, error) {
+	drv, ok := d.Driver.(interface {
+		TryLock(context.Context, string) (func() error, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.TryLock is not supported")
+	}
+	d.log(ctx, fmt.Sprintf("driver.TryLock: key=%v", key))
+	return drv.TryLock(ctx, key)
+}
+
 // Tx adds an log-id for the transaction and calls the underlying driver Tx command.
 func (d *DebugDriver) Tx(ctx context.Context) (Tx, error) {
 	tx, err := d.Driver.Tx(ctx)
@@ -206,3 +232,254 @@ func (d *DebugTx) Rollback() error {
 	d.log(d.ctx, fmt.Sprintf("Tx(%s): rollbacked", d.id))
 	return d.Tx.Rollback()
 }
+
+// ErrReadOnly is returned by read-only drivers for operations that may modify the database.
+var ErrReadOnly = errors.New("dialect: write operation on a read-only driver")
+
+// ReadOnlyDriver is a driver that rejects all operations that may modify the database.
+// i.e. all Exec operations, and Query operations that are not SELECT (or WITH) statements,
+// or that contain data-modifying clauses (e.g. in common table expressions), SELECT INTO,
+// locking clauses or calls to functions with side effects (e.g. nextval or advisory locks).
+//
+// Note that calls to user-defined functions cannot be inspected. Hence, transactions that
+// are started by the driver are also set to read-only mode in the database, if supported.
+type ReadOnlyDriver struct {
+	Driver // underlying driver.
+}
+
+// ReadOnly gets a driver and returns a new read-only driver that wraps it.
+// For example, a driver that is connected to a read replica.
+func ReadOnly(d Driver) Driver {
+	if _, ok := d.(*ReadOnlyDriver); ok {
+		return d
+	}
+	return &ReadOnlyDriver{d}
+}
+
+// Exec returns ErrReadOnly, as executing statements is not allowed on read-only drivers.
+func (d *ReadOnlyDriver) Exec(_ context.Context, query string, _, _ any) error {
+	return fmt.Errorf("%w: %s", ErrReadOnly, query)
+}
+
+// Query calls the underlying driver Query method if the given query is a read-only query.
+func (d *ReadOnlyDriver) Query(ctx context.Context, query string, args, v any) error {
+	if !readOnlyQuery(d.Dialect(), query) {
+		return fmt.Errorf("%w: %s", ErrReadOnly, query)
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx starts a read-only transaction using the underlying driver, and returns a
+// transaction that rejects operations that may modify the database. In SQLite,
+// the connection of the transaction is set to query-only mode until it ends.
+func (d *ReadOnlyDriver) Tx(ctx context.Context) (Tx, error) {
+	var (
+		tx  Tx
+		err error
+	)
+	b, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (Tx, error)
+	})
+	switch dialect := d.Dialect(); {
+	case ok && (dialect == MySQL || dialect == Postgres):
+		tx, err = b.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	case dialect == Postgres:
+		if tx, err = d.Driver.Tx(ctx); err == nil {
+			err = execTx(ctx, tx, "SET TRANSACTION READ ONLY")
+		}
+	case dialect == SQLite:
+		if tx, err = d.Driver.Tx(ctx); err == nil {
+			err = execTx(ctx, tx, "PRAGMA query_only = ON")
+		}
+	default:
+		tx, err = d.Driver.Tx(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ReadOnlyTx{tx, d.Dialect()}, nil
+}
+
+// execTx executes the given statement in the transaction, and rolls it back on failure.
+func execTx(ctx context.Context, tx Tx, query string) error {
+	if err := tx.Exec(ctx, query, []any{}, nil); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return nil
+}
+
+// ReadOnlyTx is a transaction that rejects all operations that may modify the database.
+type ReadOnlyTx struct {
+	Tx             // underlying transaction.
+	dialect string // dialect of the underlying driver.
+}
+
+// Exec returns ErrReadOnly, as executing statements is not allowed on read-only transactions.
+func (t *ReadOnlyTx) Exec(_ context.Context, query string, _, _ any) error {
+	return fmt.Errorf("%w: %s", ErrReadOnly, query)
+}
+
+// Query calls the underlying transaction Query method if the given query is a read-only query.
+func (t *ReadOnlyTx) Query(ctx context.Context, query string, args, v any) error {
+	if !readOnlyQuery(t.dialect, query) {
+		return fmt.Errorf("%w: %s", ErrReadOnly, query)
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// Commit commits the underlying transaction.
+func (t *ReadOnlyTx) Commit() error {
+	return errors.Join(t.reset(), t.Tx.Commit())
+}
+
+// Rollback rolls back the underlying transaction.
+func (t *ReadOnlyTx) Rollback() error {
+	return errors.Join(t.reset(), t.Tx.Rollback())
+}
+
+// reset resets the query-only mode of SQLite connections, before they are returned to the pool.
+func (t *ReadOnlyTx) reset() error {
+	if t.dialect != SQLite {
+		return nil
+	}
+	return t.Tx.Exec(context.Background(), "PRAGMA query_only = OFF", []any{}, nil)
+}
+
+// readOnlyQuery reports if the given query does not modify the database.
+func readOnlyQuery(dialect, query string) bool {
+	words, ok := sqlWords(dialect, query)
+	if !ok || len(words) == 0 {
+		return false
+	}
+	switch words[0].text {
+	case "SELECT", "WITH", "SHOW":
+	default:
+		return false
+	}
+	for i, w := range words {
+		switch {
+		case w.call && (sideEffectFuncs[w.text] || strings.HasPrefix(w.text, "PG_ADVISORY_") || strings.HasPrefix(w.text, "PG_TRY_ADVISORY_")):
+			return false
+		case w.call:
+		case writeKeywords[w.text]:
+			return false
+		// Locking clauses. e.g. FOR SHARE and LOCK IN SHARE MODE.
+		case w.text == "SHARE" && i > 0 && (words[i-1].text == "FOR" || words[i-1].text == "KEY" || words[i-1].text == "IN"):
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// writeKeywords holds the keywords of data-modifying statements and clauses.
+	writeKeywords = map[string]bool{
+		"INSERT": true, "UPDATE": true, "DELETE": true, "MERGE": true, "UPSERT": true,
+		"TRUNCATE": true, "DROP": true, "ALTER": true, "CREATE": true, "GRANT": true,
+		"REVOKE": true, "INTO": true, "LOCK": true, "CALL": true, "COPY": true,
+	}
+	// sideEffectFuncs holds the (upper-cased) names of builtin functions with side effects.
+	sideEffectFuncs = map[string]bool{
+		"NEXTVAL": true, "SETVAL": true, "SET_CONFIG": true, "PG_NOTIFY": true,
+		"GET_LOCK": true, "RELEASE_LOCK": true, "RELEASE_ALL_LOCKS": true,
+		"LO_CREATE": true, "LO_IMPORT": true, "LO_EXPORT": true, "LO_UNLINK": true,
+		"DBLINK_EXEC": true, "PG_TERMINATE_BACKEND": true, "PG_CANCEL_BACKEND": true,
+		"PG_RELOAD_CONF": true, "PG_ROTATE_LOGFILE": true,
+	}
+)
+
+// sqlWord is an unquoted word (i.e. keyword or identifier) in a SQL statement.
+type sqlWord struct {
+	text string // upper-cased text.
+	call bool   // followed by a parenthesis.
+}
+
+// sqlWords returns the unquoted words of the given statement, skipping literals, quoted
+// identifiers and comments. It returns false for statements that cannot be inspected,
+// such as multi-statements and MySQL executable comments.
+func sqlWords(dialect, query string) ([]sqlWord, bool) {
+	var words []sqlWord
+	for i := 0; i < len(query); {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// Backslashes escape characters in MySQL strings and in PostgreSQL E'' strings.
+			escape := c != '`' && (dialect == MySQL || i > 0 && (query[i-1] == 'E' || query[i-1] == 'e'))
+			j := i + 1
+			for ; j < len(query); j++ {
+				if escape && query[j] == '\\' {
+					j++
+					continue
+				}
+				if query[j] == c {
+					if j+1 < len(query) && query[j+1] == c {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(query) {
+				return nil, false
+			}
+			i = j + 1
+		case c == '-' && strings.HasPrefix(query[i:], "--"), c == '#' && dialect == MySQL:
+			j := strings.IndexByte(query[i:], '\n')
+			if j == -1 {
+				return words, true
+			}
+			i += j + 1
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			if dialect == MySQL && strings.HasPrefix(query[i:], "/*!") {
+				return nil, false
+			}
+			j := strings.Index(query[i+2:], "*/")
+			if j == -1 {
+				return nil, false
+			}
+			i += j + 4
+		case c == '$' && dialect == Postgres:
+			j := i + 1
+			for j < len(query) && isWordChar(query[j]) && (query[j] < '0' || query[j] > '9' || j > i+1) {
+				j++
+			}
+			if j < len(query) && query[j] == '$' {
+				// Dollar-quoted string. e.g. $$text$$ or $tag$text$tag$.
+				tag := query[i : j+1]
+				k := strings.Index(query[j+1:], tag)
+				if k == -1 {
+					return nil, false
+				}
+				i = j + 1 + k + len(tag)
+				continue
+			}
+			// Positional parameter. e.g. $1.
+			for i++; i < len(query) && isWordChar(query[i]); i++ {
+			}
+		case c == ';':
+			if strings.TrimSpace(query[i+1:]) != "" {
+				return nil, false
+			}
+			i++
+		case isWordChar(c) && (c < '0' || c > '9'):
+			j := i
+			for j < len(query) && (isWordChar(query[j]) || query[j] == '$') {
+				j++
+			}
+			w := sqlWord{text: strings.ToUpper(query[i:j])}
+			k := j
+			for k < len(query) && strings.IndexByte(" \t\r\n", query[k]) != -1 {
+				k++
+			}
+			w.call = k < len(query) && query[k] == '('
+			words = append(words, w)
+			i = j
+		default:
+			i++
+		}
+	}
+	return words, true
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
@@ -193,6 +193,30 @@ type Annotation struct {
 	//	}
 	ViewFor map[string]string `json:"view_for,omitempty"`
 
+	// Materialized indicates that the view is a materialized view. On PostgreSQL, it is created
+	// using CREATE MATERIALIZED VIEW, and on other dialects, it is emulated using a table that is
+	// populated from the view definition when the view is refreshed. For example:
+	//
+	//	entsql.Annotation{
+	//		ViewAs:       "SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id",
+	//		Materialized: true,
+	//	}
+	//
+	Materialized bool `json:"materialized,omitempty"`
+
+	// ClaimLease and ClaimOwner define the fields that are set on the entities claimed by the
+	// Claim method of the query builder (requires the "sql/claim" feature). ClaimLease holds the
+	// time until which the entity is leased, and entities with unexpired leases are not claimable.
+	// ClaimOwner holds the owner (e.g. the worker) that claimed the entity. For example:
+	//
+	//	entsql.Annotation{
+	//		ClaimLease: "leased_until",
+	//		ClaimOwner: "worker",
+	//	}
+	//
+	ClaimLease string `json:"claim_lease,omitempty"`
+	ClaimOwner string `json:"claim_owner,omitempty"`
+
 	// error occurs during annotation build. This field is not
 	// serialized to JSON and used only by the codegen loader.
 	err error
@@ -275,6 +299,30 @@ func View(as string) *Annotation {
 	return &Annotation{ViewAs: as}
 }
 
+// MaterializedView specifies the definition of a materialized view.
+func MaterializedView(as string) *Annotation {
+	return &Annotation{ViewAs: as, Materialized: true}
+}
+
+// MaterializedViewFor specifies the definition of a materialized view.
+func MaterializedViewFor(dialect string, as func(*sql.Selector)) *Annotation {
+	a := ViewFor(dialect, as)
+	a.Materialized = true
+	return a
+}
+
+// Claim specifies the lease and the owner fields that are set on
+// the entities claimed by the Claim method of the query builder.
+//
+//	func (Job) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entsql.Claim("leased_until", "worker"),
+//		}
+//	}
+func Claim(lease, owner string) *Annotation {
+	return &Annotation{ClaimLease: lease, ClaimOwner: owner}
+}
+
 // ViewFor specifies the definition of a view.
 func ViewFor(dialect string, as func(*sql.Selector)) *Annotation {
 	b := sql.Dialect(dialect).Select()
@@ -472,6 +520,15 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 			a.ViewFor[dialect] = view
 		}
 	}
+	if ant.Materialized {
+		a.Materialized = true
+	}
+	if l := ant.ClaimLease; l != "" {
+		a.ClaimLease = l
+	}
+	if o := ant.ClaimOwner; o != "" {
+		a.ClaimOwner = o
+	}
 	if ant.err != nil {
 		a.err = errors.Join(a.err, ant.err)
 	}
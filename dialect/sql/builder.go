@@ -16,10 +16,12 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field/civil"
 )
 
 // Querier wraps the basic Query method that is implemented
@@ -65,11 +67,12 @@ func (c *ColumnBuilder) Query() (string, []any) {
 // ViewBuilder is a query builder for `CREATE VIEW` statement.
 type ViewBuilder struct {
 	Builder
-	schema  string    // view schema.
-	name    string    // view name.
-	exists  bool      // check existence.
-	columns []Querier // table columns.
-	as      Querier   // view query.
+	schema       string    // view schema.
+	name         string    // view name.
+	exists       bool      // check existence.
+	materialized bool      // materialized view.
+	columns      []Querier // table columns.
+	as           Querier   // view query.
 }
 
 // CreateView returns a query builder for the `CREATE VIEW` statement.
@@ -95,6 +98,12 @@ func (v *ViewBuilder) IfNotExists() *ViewBuilder {
 	return v
 }
 
+// Materialized sets the view to be a materialized view (`CREATE MATERIALIZED VIEW`).
+func (v *ViewBuilder) Materialized() *ViewBuilder {
+	v.materialized = true
+	return v
+}
+
 // Column appends the given column to the `CREATE VIEW` statement.
 func (v *ViewBuilder) Column(c *ColumnBuilder) *ViewBuilder {
 	v.columns = append(v.columns, c)
@@ -118,11 +127,15 @@ func (v *ViewBuilder) As(as Querier) *ViewBuilder {
 
 // Query returns query representation of a `CREATE VIEW` statement.
 //
-// CREATE VIEW [IF NOT EXISTS] name AS
+// CREATE [MATERIALIZED] VIEW [IF NOT EXISTS] name AS
 //
 //	(view definition)
 func (v *ViewBuilder) Query() (string, []any) {
-	v.WriteString("CREATE VIEW ")
+	v.WriteString("CREATE ")
+	if v.materialized {
+		v.WriteString("MATERIALIZED ")
+	}
+	v.WriteString("VIEW ")
 	if v.exists {
 		v.WriteString("IF NOT EXISTS ")
 	}
@@ -373,6 +386,15 @@ func (i *InsertBuilder) OnConflict(opts ...ConflictOption) *InsertBuilder {
 	return i
 }
 
+// ConflictColumns returns the columns of the conflict target that
+// were set using the ConflictColumns option, if any.
+func (i *InsertBuilder) ConflictColumns() []string {
+	if i.conflict == nil {
+		return nil
+	}
+	return i.conflict.target.columns
+}
+
 // UpdateSet describes a set of changes of the `DO UPDATE` clause.
 type UpdateSet struct {
 	*UpdateBuilder
@@ -690,9 +712,10 @@ func (u *UpdateBuilder) writeSetter(b *Builder) {
 // DeleteBuilder is a builder for `DELETE` statement.
 type DeleteBuilder struct {
 	Builder
-	table  string
-	schema string
-	where  *Predicate
+	table     string
+	schema    string
+	where     *Predicate
+	returning []string
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -735,6 +758,13 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 	return d
 }
 
+// Returning adds the `RETURNING` clause to the delete statement.
+// Supported by SQLite and PostgreSQL.
+func (d *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	d.returning = columns
+	return d
+}
+
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []any) {
 	d.WriteString("DELETE FROM ")
@@ -744,6 +774,7 @@ func (d *DeleteBuilder) Query() (string, []any) {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
 	}
+	joinReturning(d.returning, &d.Builder)
 	return d.String(), d.args
 }
 
@@ -1332,6 +1363,91 @@ func (p *Predicate) ContainsFold(col, substr string) *Predicate {
 	return p.escapedLikeFold(col, "%", substr, "%")
 }
 
+// IPIn returns a predicate that checks if the IP address in the column is
+// contained within or equal to the given network prefix. It is supported only
+// by PostgreSQL, where it is translated to the "<<=" operator.
+func IPIn(col string, prefix netip.Prefix) *Predicate { return P().IPIn(col, prefix) }
+
+// IPIn appends a predicate that checks if the IP address in the column is
+// contained within or equal to the given network prefix.
+func (p *Predicate) IPIn(col string, prefix netip.Prefix) *Predicate {
+	return p.netOp("IPIn", col, "<<=", prefix.String())
+}
+
+// PrefixContains returns a predicate that checks if the network prefix in the
+// column contains the given IP address. It is supported only by PostgreSQL,
+// where it is translated to the ">>=" operator.
+func PrefixContains(col string, addr netip.Addr) *Predicate { return P().PrefixContains(col, addr) }
+
+// PrefixContains appends a predicate that checks if the network prefix in
+// the column contains the given IP address.
+func (p *Predicate) PrefixContains(col string, addr netip.Addr) *Predicate {
+	return p.netOp("PrefixContains", col, ">>=", addr.String())
+}
+
+// Overlaps returns a predicate that checks if the network prefix in the column
+// contains or is contained by the given prefix. It is supported only by PostgreSQL,
+// where it is translated to the "&&" operator.
+func Overlaps(col string, prefix netip.Prefix) *Predicate { return P().Overlaps(col, prefix) }
+
+// Overlaps appends a predicate that checks if the network prefix in the
+// column contains or is contained by the given prefix.
+func (p *Predicate) Overlaps(col string, prefix netip.Prefix) *Predicate {
+	return p.netOp("Overlaps", col, "&&", prefix.String())
+}
+
+// netOp appends a predicate that uses one of the PostgreSQL network operators.
+func (p *Predicate) netOp(name, col, op, arg string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.dialect != dialect.Postgres {
+			b.AddError(fmt.Errorf("%s: unsupported dialect: %q", name, b.dialect))
+			return
+		}
+		b.Ident(col).Pad().WriteString(op).Pad().Arg(arg)
+	})
+}
+
+// DaysSince returns a predicate that compares the number of days between the
+// date in the column and the given date (col - v) with n using the given operator.
+// For example, match the rows that are at least a week after v:
+//
+//	sql.DaysSince("day", v, sql.OpGTE, 7)
+func DaysSince(col string, v any, op Op, n int) *Predicate { return P().DaysSince(col, v, op, n) }
+
+// DaysSince appends a predicate that compares the number of days between
+// the date in the column and the given date with n using the given operator.
+func (p *Predicate) DaysSince(col string, v any, op Op, n int) *Predicate {
+	return p.Append(func(b *Builder) {
+		if op < OpEQ || op > OpLTE {
+			b.AddError(fmt.Errorf("DaysSince: unexpected comparison operator: %d", op))
+			return
+		}
+		switch b.dialect {
+		case dialect.Postgres:
+			// Subtracting dates yields the number of days between them.
+			b.Wrap(func(b *Builder) {
+				b.Ident(col).WriteString(" - CAST(").Arg(v).WriteString(" AS DATE)")
+			})
+		case dialect.SQLite:
+			b.WriteString("CAST").Wrap(func(b *Builder) {
+				b.WriteString("JULIANDAY").Wrap(func(b *Builder) {
+					b.Ident(col)
+				})
+				b.WriteString(" - JULIANDAY").Wrap(func(b *Builder) {
+					b.Arg(v)
+				})
+				b.WriteString(" AS INTEGER")
+			})
+		default:
+			b.WriteString("DATEDIFF").Wrap(func(b *Builder) {
+				b.Ident(col).Comma().Arg(v)
+			})
+		}
+		b.WriteOp(op)
+		b.Arg(n)
+	})
+}
+
 // CompositeGT returns a composite ">" predicate
 func CompositeGT(columns []string, args ...any) *Predicate {
 	return P().CompositeGT(columns, args...)
@@ -2929,6 +3045,7 @@ type exprFunc struct {
 func (e *exprFunc) Query() (string, []any) {
 	b := e.Builder.clone()
 	e.fn(&b)
+	e.errs = b.errs
 	return b.Query()
 }
 
@@ -3183,6 +3300,14 @@ func (b *Builder) Arg(a any) *Builder {
 			Dialect: b.dialect,
 		})
 	}
+	switch a.(type) {
+	case civil.Duration, *civil.Duration:
+		// Durations are passed as a number of nanoseconds, and stored as INTERVAL
+		// in PostgreSQL. Hence, they are converted with microsecond precision.
+		if b.postgres() {
+			format = "(CAST(" + format + " AS BIGINT) / 1000 * INTERVAL '1 microsecond')"
+		}
+	}
 	return b.Argf(format, a)
 }
 
@@ -3272,6 +3397,7 @@ func (b *Builder) Wrap(f func(*Builder)) *Builder {
 	nb.WriteByte(')')
 	b.WriteString(nb.String())
 	b.args = append(b.args, nb.args...)
+	b.errs = append(b.errs, nb.errs...)
 	b.total = nb.total
 	return b
 }
@@ -8,11 +8,14 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field/civil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,6 +50,15 @@ func TestBuilder(t *testing.T) {
 				As(Select("id", "name").From(Table("users"))),
 			wantQuery: "CREATE VIEW `schema`.`clean_users` AS SELECT `id`, `name` FROM `users`",
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateView("user_stats").
+				Materialized().
+				IfNotExists().
+				Columns(Column("owner_id"), Column("pets")).
+				As(Raw("SELECT owner_id, COUNT(*) FROM pets GROUP BY owner_id")),
+			wantQuery: `CREATE MATERIALIZED VIEW IF NOT EXISTS "user_stats" ("owner_id", "pets") AS SELECT owner_id, COUNT(*) FROM pets GROUP BY owner_id`,
+		},
 		{
 			input:     Insert("users").Columns("age").Values(1),
 			wantQuery: "INSERT INTO `users` (`age`) VALUES (?)",
@@ -546,6 +558,26 @@ func TestBuilder(t *testing.T) {
 				Schema("mydb"),
 			wantQuery: `DELETE FROM "mydb"."users" WHERE "parent_id" IS NULL`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id", "name"),
+			wantQuery: `DELETE FROM "users" WHERE "parent_id" IS NULL RETURNING "id", "name"`,
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL RETURNING `id`",
+		},
+		{
+			input: Delete("users").
+				Where(IsNull("parent_id")).
+				Returning("id"),
+			wantQuery: "DELETE FROM `users` WHERE `parent_id` IS NULL",
+		},
 		{
 			input: Delete("users").
 				Where(And(IsNull("parent_id"), NotIn("name", "foo", "bar"))),
@@ -1730,6 +1762,22 @@ func TestParamFormatter(t *testing.T) {
 	require.Equal(t, p, args[0])
 }
 
+func TestDurationParam(t *testing.T) {
+	d := civil.Duration(time.Minute)
+	query, args := Dialect(dialect.Postgres).
+		Update("jobs").
+		Set("timeout", d).
+		Where(GT("timeout", &d)).
+		Query()
+	require.Equal(t, `UPDATE "jobs" SET "timeout" = (CAST($1 AS BIGINT) / 1000 * INTERVAL '1 microsecond') WHERE "timeout" > (CAST($2 AS BIGINT) / 1000 * INTERVAL '1 microsecond')`, query)
+	require.Equal(t, []any{d, &d}, args)
+	query, _ = Dialect(dialect.SQLite).
+		Update("jobs").
+		Set("timeout", d).
+		Query()
+	require.Equal(t, "UPDATE `jobs` SET `timeout` = ?", query)
+}
+
 func TestSelectWithLock(t *testing.T) {
 	query, args := Dialect(dialect.MySQL).
 		Select().
@@ -1952,6 +2000,14 @@ func TestInsert_OnConflict(t *testing.T) {
 		require.Equal(t, "INSERT INTO `users` (`name`, `rank`) VALUES (?, ?), (?, NULL) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `rank` = VALUES(`rank`), `id` = LAST_INSERT_ID(`id`)", query)
 		require.Equal(t, []any{"Ariel", 10, "Mashraki"}, args)
 	})
+	t.Run("ConflictColumns", func(t *testing.T) {
+		i := Insert("users").Columns("name", "email").Values("a8m", "a8m@example.com")
+		require.Nil(t, i.ConflictColumns())
+		i.OnConflict(ResolveWithNewValues())
+		require.Nil(t, i.ConflictColumns())
+		i.OnConflict(ConflictColumns("email"))
+		require.Equal(t, []string{"email"}, i.ConflictColumns())
+	})
 }
 
 func TestEscapePatterns(t *testing.T) {
@@ -2335,3 +2391,54 @@ func TestColumnsHasPrefix(t *testing.T) {
 		require.Equal(t, []any{`\`}, args)
 	})
 }
+
+func TestDaysSince(t *testing.T) {
+	for _, tt := range []struct {
+		dialect string
+		query   string
+	}{
+		{dialect.Postgres, `SELECT * FROM "shifts" WHERE ("day" - CAST($1 AS DATE)) >= $2`},
+		{dialect.MySQL, "SELECT * FROM `shifts` WHERE DATEDIFF(`day`, ?) >= ?"},
+		{dialect.SQLite, "SELECT * FROM `shifts` WHERE CAST(JULIANDAY(`day`) - JULIANDAY(?) AS INTEGER) >= ?"},
+	} {
+		query, args := Dialect(tt.dialect).
+			Select("*").
+			From(Table("shifts")).
+			Where(DaysSince("day", "2024-01-01", OpGTE, 7)).
+			Query()
+		require.Equal(t, tt.query, query)
+		require.Equal(t, []any{"2024-01-01", 7}, args)
+	}
+	s := Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("shifts")).
+		Where(DaysSince("day", "2024-01-01", OpIn, 7))
+	s.Query()
+	require.EqualError(t, s.Err(), `DaysSince: unexpected comparison operator: 6`)
+}
+
+func TestNetworkPredicates(t *testing.T) {
+	t.Run("Postgres", func(t *testing.T) {
+		query, args := Dialect(dialect.Postgres).
+			Select("*").
+			From(Table("hosts")).
+			Where(
+				Or(
+					IPIn("addr", netip.MustParsePrefix("10.0.0.0/8")),
+					PrefixContains("subnet", netip.MustParseAddr("192.168.1.1")),
+					Overlaps("subnet", netip.MustParsePrefix("2001:db8::/32")),
+				),
+			).
+			Query()
+		require.Equal(t, `SELECT * FROM "hosts" WHERE "addr" <<= $1 OR "subnet" >>= $2 OR "subnet" && $3`, query)
+		require.Equal(t, []any{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"}, args)
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		s := Dialect(dialect.SQLite).
+			Select("*").
+			From(Table("hosts")).
+			Where(IPIn("addr", netip.MustParsePrefix("10.0.0.0/8")))
+		s.Query()
+		require.EqualError(t, s.Err(), `IPIn: unsupported dialect: "sqlite3"`)
+	})
+}
@@ -64,13 +64,30 @@ func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
 
 // BeginTx starts a transaction with options.
 func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
-	tx, err := d.DB().BeginTx(ctx, opts)
+	if d.dialect != dialect.MySQL {
+		tx, err := d.DB().BeginTx(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &Tx{
+			Conn: Conn{tx, d.dialect},
+			Tx:   tx,
+		}, nil
+	}
+	// MySQL transactions are started on a pinned connection, as session-level
+	// locks that were acquired by the transaction are released on it after it ends.
+	conn, err := d.DB().Conn(ctx)
 	if err != nil {
 		return nil, err
 	}
+	tx, err := conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, errors.Join(err, conn.Close())
+	}
 	return &Tx{
 		Conn: Conn{tx, d.dialect},
 		Tx:   tx,
+		conn: conn,
 	}, nil
 }
 
@@ -81,6 +98,41 @@ func (d *Driver) Close() error { return d.DB().Close() }
 type Tx struct {
 	Conn
 	driver.Tx
+	// conn is the connection the transaction was started on, if
+	// it was pinned, and after holds the functions to execute on
+	// it after the transaction ends, before it is released.
+	conn  *sql.Conn
+	after []func(*sql.Conn) error
+}
+
+// Commit commits the transaction.
+func (t *Tx) Commit() error {
+	return t.end(t.Tx.Commit())
+}
+
+// Rollback rolls back the transaction.
+func (t *Tx) Rollback() error {
+	return t.end(t.Tx.Rollback())
+}
+
+// afterEnd registers a function to execute on the connection
+// of the transaction after it is committed or rolled back.
+func (t *Tx) afterEnd(f func(*sql.Conn) error) {
+	t.after = append(t.after, f)
+}
+
+// end executes the functions registered using afterEnd, and
+// releases the pinned connection of the transaction, if any.
+func (t *Tx) end(err error) error {
+	if t.conn == nil {
+		return err
+	}
+	for _, f := range t.after {
+		err = errors.Join(err, f(t.conn))
+	}
+	conn := t.conn
+	t.conn, t.after = nil, nil
+	return errors.Join(err, conn.Close())
 }
 
 // ctyVarsKey is the key used for attaching and reading the context variables.
@@ -119,6 +171,197 @@ func WithIntVar(ctx context.Context, name string, value int) context.Context {
 	return WithVar(ctx, name, strconv.Itoa(value))
 }
 
+// ctxSchemaKey is the key used for attaching and reading the database schema from the context.
+type ctxSchemaKey struct{}
+
+// WithSchema returns a new context that holds the database schema that the statements
+// executed by a SchemaDriver are routed to. It takes precedence over the schema resolver.
+func WithSchema(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, ctxSchemaKey{}, name)
+}
+
+// SchemaFromContext returns the database schema stored in the context, if any.
+func SchemaFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(ctxSchemaKey{}).(string)
+	return name, ok
+}
+
+// SchemaDriver is a driver that routes each operation to the database schema that is
+// resolved from its context. For example, a schema-per-tenant setup, where each request
+// is executed on the schema of its tenant. On PostgreSQL, the schema is set as the
+// search_path of the transaction the statement is executed in using SET LOCAL, and
+// statements executed outside of transactions are wrapped with one. Hence, routing works
+// with any underlying driver that supports transactions (e.g. wrapped debug drivers).
+// Operations without a resolved schema are passed as-is to the underlying driver.
+type SchemaDriver struct {
+	dialect.Driver
+	resolve func(context.Context) (string, error)
+}
+
+// NewSchemaDriver returns a new SchemaDriver that wraps the given driver. The resolve function
+// is optional, and is called only if the schema was not set on the context using WithSchema.
+func NewSchemaDriver(drv dialect.Driver, resolve func(context.Context) (string, error)) *SchemaDriver {
+	return &SchemaDriver{Driver: drv, resolve: resolve}
+}
+
+// Exec executes the statement on the resolved schema using the underlying driver.
+func (d *SchemaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	name, err := d.schema(ctx)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return d.Driver.Exec(ctx, query, args, v)
+	}
+	tx, err := d.schemaTx(ctx, name, d.Driver.Tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, query, args, v); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// Query executes the query on the resolved schema using the underlying driver. In case the
+// query was wrapped with a transaction, it is committed when the returned rows are closed.
+func (d *SchemaDriver) Query(ctx context.Context, query string, args, v any) error {
+	name, err := d.schema(ctx)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return d.Driver.Query(ctx, query, args, v)
+	}
+	rows, ok := v.(*Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Rows", v)
+	}
+	tx, err := d.schemaTx(ctx, name, d.Driver.Tx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	// Statements like INSERT ... RETURNING are executed as queries,
+	// and therefore, the transaction is committed and not rolled back.
+	rows.ColumnScanner = rowsWithCloser{rows.ColumnScanner, tx.Commit}
+	return nil
+}
+
+// ExecContext executes the statement on the resolved schema using the underlying driver.
+func (d *SchemaDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var res sql.Result
+	if err := d.Exec(ctx, query, args, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QueryContext calls the underlying driver QueryContext method if it is supported. The
+// returned *sql.Rows cannot be bound to a transaction, and therefore, an error is returned
+// in case a schema was resolved for the operation. Use the Query method instead.
+func (d *SchemaDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	name, err := d.schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		return nil, fmt.Errorf("dialect/sql: QueryContext cannot be routed to schema %q", name)
+	}
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return drv.QueryContext(ctx, query, args...)
+}
+
+// Lock calls the underlying driver Lock method if it is supported.
+func (d *SchemaDriver) Lock(ctx context.Context, key string) (func() error, error) {
+	drv, ok := d.Driver.(interface {
+		Lock(context.Context, string) (func() error, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.Lock is not supported")
+	}
+	return drv.Lock(ctx, key)
+}
+
+// TryLock calls the underlying driver TryLock method if it is supported.
+func (d *SchemaDriver) TryLock(ctx context.Context, key string) (func() error, error) {
+	drv, ok := d.Driver.(interface {
+		TryLock(context.Context, string) (func() error, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.TryLock is not supported")
+	}
+	return drv.TryLock(ctx, key)
+}
+
+// Tx starts a transaction and sets the resolved schema on it.
+func (d *SchemaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.beginTx(ctx, d.Driver.Tx)
+}
+
+// BeginTx starts a transaction with options and sets the resolved schema
+// on it, if the underlying driver supports the BeginTx method.
+func (d *SchemaDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported")
+	}
+	return d.beginTx(ctx, func(ctx context.Context) (dialect.Tx, error) {
+		return drv.BeginTx(ctx, opts)
+	})
+}
+
+func (d *SchemaDriver) beginTx(ctx context.Context, begin func(context.Context) (dialect.Tx, error)) (dialect.Tx, error) {
+	name, err := d.schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return begin(ctx)
+	}
+	return d.schemaTx(ctx, name, begin)
+}
+
+// schemaTx starts a transaction and sets the given schema as its search_path. Unlike
+// session variables, the search_path is scoped to the transaction and resets on its end.
+func (d *SchemaDriver) schemaTx(ctx context.Context, name string, begin func(context.Context) (dialect.Tx, error)) (dialect.Tx, error) {
+	tx, err := begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Exec(ctx, fmt.Sprintf("SET LOCAL search_path = '%s'", name), []any{}, nil); err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	return tx, nil
+}
+
+// schema resolves the schema of the operation and returns it escaped.
+func (d *SchemaDriver) schema(ctx context.Context) (string, error) {
+	name, ok := SchemaFromContext(ctx)
+	if !ok && d.resolve != nil {
+		var err error
+		if name, err = d.resolve(ctx); err != nil {
+			return "", fmt.Errorf("dialect/sql: resolving schema: %w", err)
+		}
+	}
+	if name == "" {
+		return "", nil
+	}
+	if d.Dialect() != dialect.Postgres {
+		return "", fmt.Errorf("dialect/sql: routing operations to schema %q is not supported by %s", name, d.Dialect())
+	}
+	return strings.ReplaceAll(name, "'", "''"), nil
+}
+
 // ExecQuerier wraps the standard Exec and Query methods.
 type ExecQuerier interface {
 	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
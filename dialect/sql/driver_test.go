@@ -6,6 +6,9 @@ package sql
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"entgo.io/ent/dialect"
@@ -91,3 +94,201 @@ func TestWithVars(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 	// No rows are returned, so no need to close them.
 }
+
+type tenantKey struct{}
+
+func TestSchemaDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	drv := NewSchemaDriver(OpenDB(dialect.Postgres, db), func(ctx context.Context) (string, error) {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		if tenant == "unknown" {
+			return "", errors.New("unknown tenant")
+		}
+		return tenant, nil
+	})
+	ctx := context.WithValue(context.Background(), tenantKey{}, "t1")
+
+	// Statements outside of transactions are wrapped with one.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't1'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectCommit()
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []any{}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Explicit schema takes precedence over the resolver.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't''2'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, drv.Exec(WithSchema(ctx, "t'2"), "INSERT INTO users DEFAULT VALUES", []any{}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Failed statements roll back their transaction.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't1'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnError(errors.New("constraint"))
+	mock.ExpectRollback()
+	require.EqualError(t, drv.Exec(ctx, "INSERT INTO users DEFAULT VALUES", []any{}, nil), "constraint")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// ExecContext is routed as well.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't1'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM users").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	res, err := drv.ExecContext(ctx, "DELETE FROM users")
+	require.NoError(t, err)
+	affected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, affected)
+	require.NoError(t, mock.ExpectationsWereMet())
+	_, err = drv.QueryContext(ctx, "SELECT 1")
+	require.EqualError(t, err, `dialect/sql: QueryContext cannot be routed to schema "t1"`)
+
+	// Wrapped drivers are routed as well.
+	var logs []string
+	wrapped := NewSchemaDriver(dialect.DebugWithContext(OpenDB(dialect.Postgres, db), func(_ context.Context, v ...any) {
+		logs = append(logs, fmt.Sprint(v...))
+	}), nil)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't3'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	require.NoError(t, wrapped.Exec(WithSchema(ctx, "t3"), "INSERT INTO users DEFAULT VALUES", []any{}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.NotEmpty(t, logs)
+
+	// No schema was resolved.
+	mock.ExpectExec("INSERT INTO users DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, drv.Exec(context.Background(), "INSERT INTO users DEFAULT VALUES", []any{}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL search_path = 't1'")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT 1", []any{}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	err = drv.Exec(context.WithValue(ctx, tenantKey{}, "unknown"), "INSERT INTO users DEFAULT VALUES", []any{}, nil)
+	require.EqualError(t, err, "dialect/sql: resolving schema: unknown tenant")
+	_, err = drv.Tx(context.WithValue(ctx, tenantKey{}, "unknown"))
+	require.EqualError(t, err, "dialect/sql: resolving schema: unknown tenant")
+
+	err = NewSchemaDriver(OpenDB(dialect.MySQL, db), nil).Exec(WithSchema(ctx, "t1"), "SELECT 1", []any{}, nil)
+	require.EqualError(t, err, `dialect/sql: routing operations to schema "t1" is not supported by mysql`)
+}
+
+func TestReadOnlyDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := dialect.ReadOnly(OpenDB(dialect.Postgres, db))
+	require.Equal(t, drv, dialect.ReadOnly(drv))
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1")).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []any{}, rows))
+	require.NoError(t, rows.Close())
+	err = drv.Query(ctx, `INSERT INTO "users" DEFAULT VALUES RETURNING "id"`, []any{}, &Rows{})
+	require.ErrorIs(t, err, dialect.ErrReadOnly)
+	err = drv.Exec(ctx, `DELETE FROM "users"`, []any{}, nil)
+	require.ErrorIs(t, err, dialect.ErrReadOnly)
+
+	for _, q := range []string{
+		`WITH "t" AS (DELETE FROM "users" RETURNING *) SELECT * FROM "t"`,
+		`WITH "t" AS (UPDATE "users" SET "name" = $1 RETURNING *) SELECT * FROM "t"`,
+		`SELECT * INTO "backup" FROM "users"`,
+		`SELECT nextval('users_id_seq')`,
+		`SELECT SETVAL ('users_id_seq', 1)`,
+		`SELECT pg_advisory_lock(1)`,
+		`SELECT pg_try_advisory_xact_lock(1)`,
+		`SELECT * FROM "users" FOR UPDATE`,
+		`SELECT * FROM "users" FOR KEY SHARE`,
+		`SELECT 1; DELETE FROM "users"`,
+		`SELECT 1 /* comment`,
+		`SELECT 'unterminated`,
+	} {
+		err = drv.Query(ctx, q, []any{}, &Rows{})
+		require.ErrorIs(t, err, dialect.ErrReadOnly, q)
+	}
+	for _, q := range []string{
+		`SELECT "update", "delete" FROM "users" WHERE "name" = 'insert into'`,
+		`SELECT $$DROP TABLE "users"$$, E'it\'s into' -- DELETE FROM "users"`,
+		`SELECT /* UPDATE "users" */ COUNT(*) FROM "users" WHERE "id" = $1;`,
+		`(SELECT "name" FROM "users") UNION (SELECT "name" FROM "groups")`,
+	} {
+		mock.ExpectQuery(regexp.QuoteMeta(q)).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, q, []any{}, rows), q)
+		require.NoError(t, rows.Close())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET TRANSACTION READ ONLY")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("WITH t AS (SELECT 1) SELECT * FROM t")).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectRollback()
+	tx, err := dialect.ReadOnly(struct{ dialect.Driver }{OpenDB(dialect.Postgres, db)}).Tx(ctx)
+	require.NoError(t, err)
+	rows = &Rows{}
+	require.NoError(t, tx.Query(ctx, "WITH t AS (SELECT 1) SELECT * FROM t", []any{}, rows))
+	require.NoError(t, rows.Close())
+	err = tx.Exec(ctx, `UPDATE "users" SET "name" = $1`, []any{"a8m"}, nil)
+	require.ErrorIs(t, err, dialect.ErrReadOnly)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Drivers that support transaction options start read-only transactions directly.
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	tx, err = drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := dialect.ReadOnly(OpenDB(dialect.SQLite, db))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("PRAGMA query_only = ON")).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("PRAGMA query_only = OFF")).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		tx, err := drv.Tx(ctx)
+		require.NoError(t, err)
+		err = tx.Query(ctx, "INSERT INTO `users` DEFAULT VALUES", []any{}, &Rows{})
+		require.ErrorIs(t, err, dialect.ErrReadOnly)
+		require.NoError(t, tx.Commit())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := dialect.ReadOnly(OpenDB(dialect.MySQL, db))
+		for _, q := range []string{
+			"SELECT GET_LOCK('key', 10)",
+			"SELECT * FROM `users` LOCK IN SHARE MODE",
+			"SELECT /*!50000 1; DELETE FROM `users` */",
+			"SELECT 'it\\'s' # ; DELETE FROM `users`\n; UPDATE `users` SET `name` = 'a'",
+		} {
+			err = drv.Query(ctx, q, []any{}, &Rows{})
+			require.ErrorIs(t, err, dialect.ErrReadOnly, q)
+		}
+		q := "SELECT `into` FROM `users` WHERE `name` = 'it\\'s; update'"
+		mock.ExpectQuery(regexp.QuoteMeta(q)).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, q, []any{}, rows))
+		require.NoError(t, rows.Close())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,609 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// Expression represents a composable and dialect-aware SQL expression.
+// Expressions can be used anywhere a Querier is accepted, for example,
+// in Selector.AppendSelectExprAs, Selector.OrderExpr and UpdateBuilder.Set.
+//
+//	t := sql.Table("users")
+//	sql.Select().
+//		AppendSelectExprAs(sql.Col(t.C("price")).Mul(sql.Col(t.C("qty"))), "total").
+//		From(t).
+//		Where(sql.Coalesce(sql.Col(t.C("nickname")), sql.Col(t.C("name"))).EQ("a8m"))
+//
+// Values that are passed to expressions and are not a Querier are added to
+// the query as bound arguments.
+type Expression struct {
+	Builder
+	fn func(*Builder)
+}
+
+// newExpr returns a new expression that evaluates the given function.
+func newExpr(fn func(*Builder)) *Expression {
+	return &Expression{fn: fn}
+}
+
+// Query implements the Querier interface.
+func (e *Expression) Query() (string, []any) {
+	b := e.Builder.clone()
+	e.fn(&b)
+	e.errs = b.errs
+	return b.Query()
+}
+
+// Col returns a column reference expression.
+//
+//	sql.Col("age").Add(1)
+//	sql.Col(t.C("age")).GT(18)
+func Col(name string) *Expression {
+	return newExpr(func(b *Builder) {
+		b.Ident(name)
+	})
+}
+
+// Lit returns an expression for the given value. The value
+// is added to the query as a bound argument.
+//
+//	sql.Lit(10).Sub(sql.Col("age"))
+func Lit(v any) *Expression {
+	return newExpr(func(b *Builder) {
+		b.Arg(v)
+	})
+}
+
+// Now returns an expression for the current date and time.
+func Now() *Expression {
+	return newExpr(func(b *Builder) {
+		b.WriteString("CURRENT_TIMESTAMP")
+	})
+}
+
+// Call returns an expression that calls the SQL function
+// with the given name and arguments.
+//
+//	sql.Call("GREATEST", sql.Col("a"), sql.Col("b"))
+func Call(name string, args ...any) *Expression {
+	return newExpr(func(b *Builder) {
+		b.WriteString(name).Wrap(func(b *Builder) {
+			b.Args(args...)
+		})
+	})
+}
+
+// Coalesce returns the COALESCE expression of the given values.
+//
+//	sql.Coalesce(sql.Col("nickname"), sql.Col("name"), "unknown")
+func Coalesce(vs ...any) *Expression {
+	return Call("COALESCE", vs...)
+}
+
+// NullIf returns the NULLIF expression of the given values.
+//
+//	sql.Col("total").Div(sql.NullIf(sql.Col("count"), 0))
+func NullIf(v1, v2 any) *Expression {
+	return Call("NULLIF", v1, v2)
+}
+
+// CastType represents a portable type for the CAST expression.
+type CastType uint
+
+// Portable types for the CAST expression.
+const (
+	CastInt    CastType = iota + 1 // SIGNED in MySQL, BIGINT in PostgreSQL and INTEGER in SQLite.
+	CastFloat                      // DOUBLE in MySQL, DOUBLE PRECISION in PostgreSQL and REAL in SQLite.
+	CastString                     // CHAR in MySQL and TEXT in PostgreSQL and SQLite.
+	CastTime                       // DATETIME in MySQL and TIMESTAMP in PostgreSQL.
+	CastDate                       // DATE in MySQL and PostgreSQL.
+)
+
+// Cast returns an expression that converts the given value to the given type.
+// SQLite does not have date and time types, and therefore, the values are
+// converted using the DATETIME and DATE functions.
+//
+//	sql.Cast(sql.Col("price"), sql.CastInt)
+func Cast(v any, t CastType) *Expression {
+	return newExpr(func(b *Builder) {
+		if t < CastInt || t > CastDate {
+			b.AddError(fmt.Errorf("sql: unknown cast type %d", t))
+			return
+		}
+		var typ string
+		switch d := b.Dialect(); {
+		case d == dialect.SQLite && (t == CastTime || t == CastDate):
+			name := "DATETIME"
+			if t == CastDate {
+				name = "DATE"
+			}
+			b.WriteString(name).Wrap(func(b *Builder) {
+				b.Arg(v)
+			})
+			return
+		case d == dialect.SQLite:
+			typ = [...]string{CastInt: "INTEGER", CastFloat: "REAL", CastString: "TEXT"}[t]
+		case d == dialect.Postgres:
+			typ = [...]string{CastInt: "BIGINT", CastFloat: "DOUBLE PRECISION", CastString: "TEXT", CastTime: "TIMESTAMP", CastDate: "DATE"}[t]
+		default:
+			typ = [...]string{CastInt: "SIGNED", CastFloat: "DOUBLE", CastString: "CHAR", CastTime: "DATETIME", CastDate: "DATE"}[t]
+		}
+		b.WriteString("CAST").Wrap(func(b *Builder) {
+			b.Arg(v).WriteString(" AS ").WriteString(typ)
+		})
+	})
+}
+
+// CaseBuilder is a builder for the `CASE` expression.
+type CaseBuilder struct {
+	whens []caseWhen
+	els   any
+	ok    bool // else was set.
+}
+
+type caseWhen struct {
+	cond *Predicate
+	then any
+}
+
+// Case returns a builder for the `CASE` expression.
+//
+//	sql.Case().
+//		When(sql.GTE("age", 18), "adult").
+//		Else("minor").
+//		End()
+func Case() *CaseBuilder {
+	return &CaseBuilder{}
+}
+
+// When appends a `WHEN <cond> THEN <then>` clause to the expression.
+func (c *CaseBuilder) When(cond *Predicate, then any) *CaseBuilder {
+	c.whens = append(c.whens, caseWhen{cond: cond, then: then})
+	return c
+}
+
+// Else sets the `ELSE` clause of the expression.
+func (c *CaseBuilder) Else(v any) *CaseBuilder {
+	c.els, c.ok = v, true
+	return c
+}
+
+// End returns the `CASE` expression.
+func (c *CaseBuilder) End() *Expression {
+	return newExpr(func(b *Builder) {
+		b.WriteString("CASE")
+		for _, w := range c.whens {
+			b.WriteString(" WHEN ").Join(w.cond).WriteString(" THEN ").Arg(w.then)
+		}
+		if c.ok {
+			b.WriteString(" ELSE ").Arg(c.els)
+		}
+		b.WriteString(" END")
+	})
+}
+
+// Add returns the `e + v` expression.
+func (e *Expression) Add(v any) *Expression {
+	return e.op(OpAdd, v)
+}
+
+// Sub returns the `e - v` expression.
+func (e *Expression) Sub(v any) *Expression {
+	return e.op(OpSub, v)
+}
+
+// Mul returns the `e * v` expression.
+func (e *Expression) Mul(v any) *Expression {
+	return e.op(OpMul, v)
+}
+
+// Div returns the `e / v` expression.
+func (e *Expression) Div(v any) *Expression {
+	return e.op(OpDiv, v)
+}
+
+// Mod returns the `e % v` expression.
+func (e *Expression) Mod(v any) *Expression {
+	return e.op(OpMod, v)
+}
+
+// op returns the arithmetic expression wrapped with parentheses.
+func (e *Expression) op(op Op, v any) *Expression {
+	return newExpr(func(b *Builder) {
+		b.Wrap(func(b *Builder) {
+			b.Join(e).WriteOp(op).Arg(v)
+		})
+	})
+}
+
+// Lower returns the expression converted to lowercase.
+func (e *Expression) Lower() *Expression {
+	return Call("LOWER", e)
+}
+
+// Upper returns the expression converted to uppercase.
+func (e *Expression) Upper() *Expression {
+	return Call("UPPER", e)
+}
+
+// Trim returns the expression with leading and trailing spaces removed.
+func (e *Expression) Trim() *Expression {
+	return Call("TRIM", e)
+}
+
+// Length returns the number of characters in the expression.
+func (e *Expression) Length() *Expression {
+	return newExpr(func(b *Builder) {
+		name := "CHAR_LENGTH"
+		if b.sqlite() {
+			name = "LENGTH"
+		}
+		b.WriteString(name).Wrap(func(b *Builder) {
+			b.Join(e)
+		})
+	})
+}
+
+// Substr returns the substring of the expression that starts at
+// the given position (1-based) and has the given length.
+func (e *Expression) Substr(pos, n int) *Expression {
+	return Call("SUBSTR", e, pos, n)
+}
+
+// Concat returns the concatenation of the expression with the given values.
+func (e *Expression) Concat(vs ...any) *Expression {
+	return newExpr(func(b *Builder) {
+		if !b.postgres() && !b.sqlite() {
+			b.WriteString("CONCAT").Wrap(func(b *Builder) {
+				b.Join(e).Comma().Args(vs...)
+			})
+			return
+		}
+		b.Wrap(func(b *Builder) {
+			b.Join(e)
+			for _, v := range vs {
+				b.WriteString(" || ").Arg(v)
+			}
+		})
+	})
+}
+
+// Abs returns the absolute value of the expression.
+func (e *Expression) Abs() *Expression {
+	return Call("ABS", e)
+}
+
+// Round returns the expression rounded to the given number of decimal places.
+func (e *Expression) Round(digits int) *Expression {
+	return newExpr(func(b *Builder) {
+		b.WriteString("ROUND").Wrap(func(b *Builder) {
+			switch {
+			case digits == 0:
+				b.Join(e)
+			// PostgreSQL supports rounding to decimal places only for numeric values.
+			case b.postgres():
+				b.WriteString("CAST").Wrap(func(b *Builder) {
+					b.Join(e).WriteString(" AS NUMERIC")
+				})
+				b.Comma().WriteString(strconv.Itoa(digits))
+			default:
+				b.Join(e).Comma().WriteString(strconv.Itoa(digits))
+			}
+		})
+	})
+}
+
+// Year returns the year part of the date expression.
+func (e *Expression) Year() *Expression {
+	return e.extract("YEAR", "%Y")
+}
+
+// Month returns the month part of the date expression.
+func (e *Expression) Month() *Expression {
+	return e.extract("MONTH", "%m")
+}
+
+// Day returns the day of the month part of the date expression.
+func (e *Expression) Day() *Expression {
+	return e.extract("DAY", "%d")
+}
+
+// Hour returns the hour part of the time expression.
+func (e *Expression) Hour() *Expression {
+	return e.extract("HOUR", "%H")
+}
+
+// Minute returns the minute part of the time expression.
+func (e *Expression) Minute() *Expression {
+	return e.extract("MINUTE", "%M")
+}
+
+// extract returns the given part of a date or time expression. SQLite does not
+// support the EXTRACT function, and therefore, the part is formatted using the
+// strftime function and converted to an integer.
+func (e *Expression) extract(part, format string) *Expression {
+	return newExpr(func(b *Builder) {
+		if b.sqlite() {
+			b.WriteString("CAST").Wrap(func(b *Builder) {
+				b.WriteString("STRFTIME").Wrap(func(b *Builder) {
+					b.WriteString("'" + format + "'").Comma().Join(e)
+				})
+				b.WriteString(" AS INTEGER")
+			})
+			return
+		}
+		b.WriteString("EXTRACT").Wrap(func(b *Builder) {
+			b.WriteString(part).WriteString(" FROM ").Join(e)
+		})
+	})
+}
+
+// A TimeUnit is a unit for truncating date and time expressions.
+type TimeUnit string
+
+// Units for truncating date and time expressions.
+const (
+	Minute TimeUnit = "minute"
+	Hour   TimeUnit = "hour"
+	Day    TimeUnit = "day"
+	Week   TimeUnit = "week"
+	Month  TimeUnit = "month"
+	Year   TimeUnit = "year"
+)
+
+// truncFormats holds the MySQL and SQLite formats for truncating
+// date and time values. Weeks are truncated separately.
+var truncFormats = map[TimeUnit]struct{ mysql, sqlite string }{
+	Minute: {"%Y-%m-%d %H:%i:00", "%Y-%m-%d %H:%M:00"},
+	Hour:   {"%Y-%m-%d %H:00:00", "%Y-%m-%d %H:00:00"},
+	Day:    {"%Y-%m-%d 00:00:00", "%Y-%m-%d 00:00:00"},
+	Week:   {"", "%Y-%m-%d 00:00:00"},
+	Month:  {"%Y-%m-01 00:00:00", "%Y-%m-01 00:00:00"},
+	Year:   {"%Y-01-01 00:00:00", "%Y-01-01 00:00:00"},
+}
+
+// Trunc returns the date or time expression truncated to the given unit. Weeks
+// start on Monday. If tz is not empty, the expression is truncated in the given
+// IANA time zone, instead of UTC. For example:
+//
+//	sql.Col("created_at").Trunc(sql.Day, "Europe/Berlin")
+//
+// The expression is mapped to DATE_TRUNC in PostgreSQL, DATE_FORMAT in MySQL,
+// and STRFTIME in SQLite. Note that MySQL requires the time zone tables to be
+// loaded for named time zones, and SQLite supports only the UTC time zone.
+func (e *Expression) Trunc(unit TimeUnit, tz string) *Expression {
+	return newExpr(func(b *Builder) {
+		f, ok := truncFormats[unit]
+		if !ok {
+			b.AddError(fmt.Errorf("sql: unknown time unit %q", unit))
+			return
+		}
+		quote := func(s string) string {
+			return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		}
+		switch {
+		case b.postgres():
+			b.WriteString("DATE_TRUNC").Wrap(func(b *Builder) {
+				b.WriteString(quote(string(unit))).Comma().Join(e)
+				if tz != "" {
+					b.Comma().WriteString(quote(tz))
+				}
+			})
+		case b.sqlite():
+			if tz != "" && tz != "UTC" {
+				b.AddError(fmt.Errorf("sql: time zone %q is not supported by SQLite", tz))
+				return
+			}
+			b.WriteString("STRFTIME").Wrap(func(b *Builder) {
+				b.WriteString(quote(f.sqlite)).Comma().Join(e)
+				if unit == Week {
+					b.WriteString(", '-6 days', 'weekday 1'")
+				}
+			})
+		default:
+			x := Querier(e)
+			// Time values are stored in UTC.
+			if tz != "" && tz != "UTC" {
+				x = Call("CONVERT_TZ", e, Raw("'+00:00'"), Raw(quote(tz)))
+			}
+			b.WriteString("CAST").Wrap(func(b *Builder) {
+				if unit == Week {
+					b.WriteString("DATE_SUB(DATE(").Join(x).WriteString("), INTERVAL WEEKDAY(").Join(x).WriteString(") DAY)")
+				} else {
+					b.WriteString("DATE_FORMAT").Wrap(func(b *Builder) {
+						b.Join(x).Comma().WriteString(quote(f.mysql))
+					})
+				}
+				b.WriteString(" AS DATETIME")
+			})
+		}
+	})
+}
+
+// DateAdd returns the date or time expression with n units added. For example:
+//
+//	sql.Col("created_at").DateAdd(7, sql.Day).LT(sql.Now())
+//
+// The expression is mapped to the `+ INTERVAL` operator in PostgreSQL, DATE_ADD
+// in MySQL, and DATETIME with a modifier in SQLite.
+func (e *Expression) DateAdd(n int, unit TimeUnit) *Expression {
+	return newExpr(func(b *Builder) {
+		if _, ok := truncFormats[unit]; !ok {
+			b.AddError(fmt.Errorf("sql: unknown time unit %q", unit))
+			return
+		}
+		switch {
+		case b.postgres():
+			b.Wrap(func(b *Builder) {
+				b.Join(e).WriteString(fmt.Sprintf(" + INTERVAL '%d %s'", n, unit))
+			})
+		case b.sqlite():
+			// SQLite does not support weeks as modifiers.
+			if unit == Week {
+				n, unit = n*7, Day
+			}
+			b.WriteString("DATETIME").Wrap(func(b *Builder) {
+				b.Join(e).WriteString(fmt.Sprintf(", '%+d %ss'", n, unit))
+			})
+		default:
+			b.WriteString("DATE_ADD").Wrap(func(b *Builder) {
+				b.Join(e).WriteString(fmt.Sprintf(", INTERVAL %d %s", n, strings.ToUpper(string(unit))))
+			})
+		}
+	})
+}
+
+// DateSub returns the date or time expression with n units subtracted.
+//
+//	sql.Col("expires_at").DateSub(1, sql.Hour).LTE(sql.Now())
+func (e *Expression) DateSub(n int, unit TimeUnit) *Expression {
+	return e.DateAdd(-n, unit)
+}
+
+// unitSeconds holds the number of seconds of the fixed-length time units.
+var unitSeconds = map[TimeUnit]int{
+	Minute: 60,
+	Hour:   60 * 60,
+	Day:    24 * 60 * 60,
+	Week:   7 * 24 * 60 * 60,
+}
+
+// DateDiff returns the number of whole units between the date or time value v
+// and the expression (e - v). The result is negative if v is after e. For example:
+//
+//	sql.Col("updated_at").DateDiff(sql.Col("created_at"), sql.Hour).GT(24)
+//
+// The expression is mapped to TIMESTAMPDIFF in MySQL, to the seconds of the
+// interval (or to AGE for months and years) in PostgreSQL, and to the difference
+// of JULIANDAY values in SQLite, which does not support months and years.
+func (e *Expression) DateDiff(v any, unit TimeUnit) *Expression {
+	return newExpr(func(b *Builder) {
+		secs, ok := unitSeconds[unit]
+		switch {
+		case !ok && unit != Month && unit != Year:
+			b.AddError(fmt.Errorf("sql: unknown time unit %q", unit))
+		case b.postgres() && !ok:
+			age := func(b *Builder, part string) {
+				b.WriteString("EXTRACT").Wrap(func(b *Builder) {
+					b.WriteString(part + " FROM AGE").Wrap(func(b *Builder) {
+						b.Join(e).Comma().Arg(v)
+					})
+				})
+			}
+			b.WriteString("CAST").Wrap(func(b *Builder) {
+				age(b, "YEAR")
+				if unit == Month {
+					b.WriteString(" * 12 + ")
+					age(b, "MONTH")
+				}
+				b.WriteString(" AS BIGINT")
+			})
+		case b.postgres():
+			b.Wrap(func(b *Builder) {
+				b.WriteString("CAST").Wrap(func(b *Builder) {
+					b.WriteString("EXTRACT").Wrap(func(b *Builder) {
+						b.WriteString("EPOCH FROM ").Wrap(func(b *Builder) {
+							b.Join(e).WriteString(" - ").Arg(v)
+						})
+					})
+					b.WriteString(" AS BIGINT")
+				})
+				b.WriteString(" / " + strconv.Itoa(secs))
+			})
+		case b.sqlite() && !ok:
+			b.AddError(fmt.Errorf("sql: time unit %q is not supported by SQLite", unit))
+		case b.sqlite():
+			b.Wrap(func(b *Builder) {
+				b.WriteString("CAST(ROUND((JULIANDAY").Wrap(func(b *Builder) {
+					b.Join(e)
+				})
+				b.WriteString(" - JULIANDAY").Wrap(func(b *Builder) {
+					b.Arg(v)
+				})
+				b.WriteString(") * 86400) AS INTEGER) / " + strconv.Itoa(secs))
+			})
+		default:
+			b.WriteString("TIMESTAMPDIFF").Wrap(func(b *Builder) {
+				b.WriteString(strings.ToUpper(string(unit))).Comma().Arg(v).Comma().Join(e)
+			})
+		}
+	})
+}
+
+// EQ returns the `e = v` predicate.
+//
+//	sql.Col("a").Add(sql.Col("b")).EQ(10)
+func (e *Expression) EQ(v any) *Predicate {
+	return e.cmp(OpEQ, v)
+}
+
+// NEQ returns the `e <> v` predicate.
+func (e *Expression) NEQ(v any) *Predicate {
+	return e.cmp(OpNEQ, v)
+}
+
+// GT returns the `e > v` predicate.
+func (e *Expression) GT(v any) *Predicate {
+	return e.cmp(OpGT, v)
+}
+
+// GTE returns the `e >= v` predicate.
+func (e *Expression) GTE(v any) *Predicate {
+	return e.cmp(OpGTE, v)
+}
+
+// LT returns the `e < v` predicate.
+func (e *Expression) LT(v any) *Predicate {
+	return e.cmp(OpLT, v)
+}
+
+// LTE returns the `e <= v` predicate.
+func (e *Expression) LTE(v any) *Predicate {
+	return e.cmp(OpLTE, v)
+}
+
+// Like returns the `e LIKE pattern` predicate.
+func (e *Expression) Like(pattern string) *Predicate {
+	return e.cmp(OpLike, pattern)
+}
+
+// In returns the `e IN (vs...)` predicate.
+func (e *Expression) In(vs ...any) *Predicate {
+	if len(vs) == 0 {
+		return False()
+	}
+	return P(func(b *Builder) {
+		b.Join(e).WriteOp(OpIn).Wrap(func(b *Builder) {
+			b.Args(vs...)
+		})
+	})
+}
+
+// IsNull returns the `e IS NULL` predicate.
+func (e *Expression) IsNull() *Predicate {
+	return P(func(b *Builder) {
+		b.Join(e).WriteOp(OpIsNull)
+	})
+}
+
+// NotNull returns the `e IS NOT NULL` predicate.
+func (e *Expression) NotNull() *Predicate {
+	return P(func(b *Builder) {
+		b.Join(e).WriteOp(OpNotNull)
+	})
+}
+
+// cmp returns the comparison predicate of the expression and the given value.
+func (e *Expression) cmp(op Op, v any) *Predicate {
+	return P(func(b *Builder) {
+		b.Join(e).WriteOp(op).Arg(v)
+	})
+}
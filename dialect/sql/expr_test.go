@@ -0,0 +1,193 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression(t *testing.T) {
+	tests := []struct {
+		input     Querier
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			input: func() Querier {
+				t := Table("items")
+				return Select(t.C("id")).
+					AppendSelectExprAs(Col(t.C("price")).Mul(Col(t.C("qty"))).Sub(Col(t.C("discount"))), "total").
+					From(t).
+					Where(Col(t.C("price")).Add(1).GT(10))
+			}(),
+			wantQuery: "SELECT `items`.`id`, (((`items`.`price` * `items`.`qty`) - `items`.`discount`)) AS `total` FROM `items` WHERE (`items`.`price` + ?) > ?",
+			wantArgs:  []any{1, 10},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("id").
+				AppendSelectExprAs(
+					Case().
+						When(GTE("age", 18), "adult").
+						When(IsNull("age"), Lit(nil)).
+						Else("minor").
+						End(),
+					"kind",
+				).
+				From(Table("users")).
+				Where(Coalesce(Col("nickname"), Col("name"), "unknown").EQ("a8m")),
+			wantQuery: `SELECT "id", (CASE WHEN "age" >= $1 THEN $2 WHEN "age" IS NULL THEN NULL ELSE $3 END) AS "kind" FROM "users" WHERE COALESCE("nickname", "name", $4) = $5`,
+			wantArgs:  []any{18, "adult", "minor", "unknown", "a8m"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				AppendSelectExprAs(Col("total").Div(NullIf(Col("count"), 0)).Round(2), "avg").
+				From(Table("stats")).
+				OrderExpr(DescExpr(Col("total").Abs())),
+			wantQuery: `SELECT (ROUND(CAST(("total" / NULLIF("count", $1)) AS NUMERIC), 2)) AS "avg" FROM "stats" ORDER BY ABS("total") DESC`,
+			wantArgs:  []any{0},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				Set("name", Col("first").Concat(" ", Col("last")).Trim()).
+				Set("age", Col("age").Add(1)).
+				Where(Col("name").Lower().Like("a%")),
+			wantQuery: `UPDATE "users" SET "name" = TRIM(("first" || $1 || "last")), "age" = ("age" + $2) WHERE LOWER("name") LIKE $3`,
+			wantArgs:  []any{" ", 1, "a%"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Update("users").
+				Set("name", Col("first").Concat(" ", Col("last")).Upper()).
+				Where(Col("name").Length().In(1, 2)),
+			wantQuery: "UPDATE `users` SET `name` = UPPER(CONCAT(`first`, ?, `last`)) WHERE CHAR_LENGTH(`name`) IN (?, ?)",
+			wantArgs:  []any{" ", 1, 2},
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				AppendSelectExprAs(Cast(Col("price"), CastInt), "price").
+				AppendSelectExprAs(Cast(Col("created_at"), CastDate), "day").
+				AppendSelectExprAs(Col("created_at").Year(), "year").
+				From(Table("items")).
+				Where(And(Col("name").Substr(1, 3).NEQ("abc"), Col("name").Length().LT(10), Col("deleted_at").NotNull())),
+			wantQuery: "SELECT (CAST(`price` AS INTEGER)) AS `price`, (DATE(`created_at`)) AS `day`, (CAST(STRFTIME('%Y', `created_at`) AS INTEGER)) AS `year` FROM `items` WHERE SUBSTR(`name`, ?, ?) <> ? AND LENGTH(`name`) < ? AND `deleted_at` IS NOT NULL",
+			wantArgs:  []any{1, 3, "abc", 10},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				AppendSelectExprAs(Cast(Col("price"), CastFloat), "price").
+				AppendSelectExprAs(Col("created_at").Month(), "month").
+				From(Table("items")).
+				Where(Col("created_at").LTE(Now())),
+			wantQuery: `SELECT (CAST("price" AS DOUBLE PRECISION)) AS "price", (EXTRACT(MONTH FROM "created_at")) AS "month" FROM "items" WHERE "created_at" <= CURRENT_TIMESTAMP`,
+		},
+		{
+			input: Select().
+				AppendSelectExprAs(Cast(Lit("10"), CastInt).Mod(3), "m").
+				AppendSelectExprAs(Call("GREATEST", Col("a"), Col("b"), 0), "g").
+				From(Table("t")).
+				Where(Col("a").In()),
+			wantQuery: "SELECT ((CAST(? AS SIGNED) % ?)) AS `m`, (GREATEST(`a`, `b`, ?)) AS `g` FROM `t` WHERE FALSE",
+			wantArgs:  []any{"10", 3, 0},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				AppendSelectExprAs(Col("created_at").Trunc(Day, ""), "bucket").
+				AppendSelectExprAs(Col("created_at").Trunc(Week, "Europe/Berlin"), "week").
+				From(Table("users")),
+			wantQuery: `SELECT (DATE_TRUNC('day', "created_at")) AS "bucket", (DATE_TRUNC('week', "created_at", 'Europe/Berlin')) AS "week" FROM "users"`,
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select().
+				AppendSelectExprAs(Col("created_at").Trunc(Month, ""), "bucket").
+				AppendSelectExprAs(Col("created_at").Trunc(Week, "Europe/Berlin"), "week").
+				From(Table("users")),
+			wantQuery: "SELECT (CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME)) AS `bucket`, (CAST(DATE_SUB(DATE(CONVERT_TZ(`created_at`, '+00:00', 'Europe/Berlin')), INTERVAL WEEKDAY(CONVERT_TZ(`created_at`, '+00:00', 'Europe/Berlin')) DAY) AS DATETIME)) AS `week` FROM `users`",
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				AppendSelectExprAs(Col("created_at").Trunc(Hour, "UTC"), "bucket").
+				AppendSelectExprAs(Col("created_at").Trunc(Week, ""), "week").
+				From(Table("users")),
+			wantQuery: "SELECT (STRFTIME('%Y-%m-%d %H:00:00', `created_at`)) AS `bucket`, (STRFTIME('%Y-%m-%d 00:00:00', `created_at`, '-6 days', 'weekday 1')) AS `week` FROM `users`",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				AppendSelectExprAs(Col("updated_at").DateDiff(Col("created_at"), Hour), "hours").
+				AppendSelectExprAs(Col("updated_at").DateDiff(Col("created_at"), Month), "months").
+				From(Table("users")).
+				Where(Col("created_at").DateAdd(7, Day).LT(Now())),
+			wantQuery: `SELECT ((CAST(EXTRACT(EPOCH FROM ("updated_at" - "created_at")) AS BIGINT) / 3600)) AS "hours", (CAST(EXTRACT(YEAR FROM AGE("updated_at", "created_at")) * 12 + EXTRACT(MONTH FROM AGE("updated_at", "created_at")) AS BIGINT)) AS "months" FROM "users" WHERE ("created_at" + INTERVAL '7 day') < CURRENT_TIMESTAMP`,
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select().
+				AppendSelectExprAs(Col("updated_at").DateDiff(Col("created_at"), Week), "weeks").
+				From(Table("users")).
+				Where(Col("expires_at").DateSub(1, Hour).LTE(Now())),
+			wantQuery: "SELECT (TIMESTAMPDIFF(WEEK, `created_at`, `updated_at`)) AS `weeks` FROM `users` WHERE DATE_ADD(`expires_at`, INTERVAL -1 HOUR) <= CURRENT_TIMESTAMP",
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				AppendSelectExprAs(Col("updated_at").DateDiff("2025-01-01", Day), "days").
+				From(Table("users")).
+				Where(Col("created_at").DateAdd(2, Week).LT(Now())),
+			wantQuery: "SELECT ((CAST(ROUND((JULIANDAY(`updated_at`) - JULIANDAY(?)) * 86400) AS INTEGER) / 86400)) AS `days` FROM `users` WHERE DATETIME(`created_at`, '+14 days') < CURRENT_TIMESTAMP",
+			wantArgs:  []any{"2025-01-01"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestExpression_Err(t *testing.T) {
+	x := Col("created_at").Trunc(Day, "Europe/Berlin")
+	x.SetDialect(dialect.SQLite)
+	x.Query()
+	require.EqualError(t, x.Err(), `sql: time zone "Europe/Berlin" is not supported by SQLite`)
+
+	x = Col("created_at").Trunc("decade", "")
+	x.Query()
+	require.EqualError(t, x.Err(), `sql: unknown time unit "decade"`)
+
+	for _, ct := range []CastType{0, CastDate + 1} {
+		x = Cast(Col("price"), ct)
+		query, _ := x.Query()
+		require.Empty(t, query)
+		require.EqualError(t, x.Err(), fmt.Sprintf("sql: unknown cast type %d", ct))
+	}
+	s := Select().AppendSelectExprAs(Cast(Col("price"), 0), "price").From(Table("items"))
+	s.Query()
+	require.EqualError(t, s.Err(), "sql: unknown cast type 0")
+
+	x = Col("created_at").DateAdd(1, "decade")
+	x.Query()
+	require.EqualError(t, x.Err(), `sql: unknown time unit "decade"`)
+
+	x = Col("updated_at").DateDiff(Col("created_at"), Year)
+	x.SetDialect(dialect.SQLite)
+	x.Query()
+	require.EqualError(t, x.Err(), `sql: time unit "year" is not supported by SQLite`)
+}
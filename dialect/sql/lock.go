@@ -0,0 +1,258 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// ErrLocked is returned when an advisory lock cannot be acquired without
+// waiting, because it is held by another session or transaction.
+var ErrLocked = errors.New("sql: lock is held by another session")
+
+// LockTable is the name of the table that is used for emulating advisory
+// locks in dialects that do not support them (i.e. SQLite).
+const LockTable = "ent_locks"
+
+// lockPollInterval defines how often an emulated lock is polled while waiting for it.
+var lockPollInterval = 50 * time.Millisecond
+
+// Lock acquires a session-level advisory lock for the given key, and waits until the lock is
+// acquired or the context is done. The lock is held on a dedicated (pinned) connection until
+// the returned release function is called, and therefore, cannot be released by the reuse of
+// the connection by the pool.
+//
+// On PostgreSQL, it is implemented using pg_advisory_lock with a 64-bit hash of the key, on MySQL,
+// using GET_LOCK, and on SQLite, it is emulated using rows in the LockTable.
+//
+//	release, err := drv.Lock(ctx, "cron:cleanup")
+//	if err != nil {
+//		return err
+//	}
+//	defer release()
+func (d *Driver) Lock(ctx context.Context, key string) (func() error, error) {
+	return d.lock(ctx, key, true)
+}
+
+// TryLock is like Lock, but returns ErrLocked instead of waiting if
+// the lock is held by another session.
+func (d *Driver) TryLock(ctx context.Context, key string) (func() error, error) {
+	return d.lock(ctx, key, false)
+}
+
+func (d *Driver) lock(ctx context.Context, key string, wait bool) (func() error, error) {
+	if d.Dialect() == dialect.SQLite {
+		return d.lockTable(ctx, key, wait)
+	}
+	conn, err := d.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		arg              any
+		acquire, release string
+	)
+	switch d.Dialect() {
+	case dialect.Postgres:
+		arg = lockID(key)
+		acquire, release = "SELECT pg_try_advisory_lock($1)", "SELECT pg_advisory_unlock($1)"
+		if wait {
+			// pg_advisory_lock returns void, and waits until the lock is acquired.
+			acquire = "SELECT true FROM pg_advisory_lock($1)"
+		}
+	case dialect.MySQL:
+		arg = lockName(key)
+		acquire, release = "SELECT GET_LOCK(?, 0)", "SELECT RELEASE_LOCK(?)"
+		if wait {
+			acquire = "SELECT GET_LOCK(?, -1)"
+		}
+	default:
+		return nil, errors.Join(fmt.Errorf("sql: advisory locks are not supported by %s", d.Dialect()), conn.Close())
+	}
+	var acquired sql.NullBool
+	if err := conn.QueryRowContext(ctx, acquire, arg).Scan(&acquired); err != nil {
+		return nil, errors.Join(fmt.Errorf("sql: acquiring lock %q: %w", key, err), conn.Close())
+	}
+	if !acquired.Valid || !acquired.Bool {
+		return nil, errors.Join(ErrLocked, conn.Close())
+	}
+	return releaseOnce(func() error {
+		_, err := conn.ExecContext(context.Background(), release, arg)
+		return errors.Join(err, conn.Close())
+	}), nil
+}
+
+// lockTable acquires a lock by inserting its key to the LockTable. Note that unlike
+// advisory locks, an emulated lock is not released in case the session is terminated.
+func (d *Driver) lockTable(ctx context.Context, key string, wait bool) (func() error, error) {
+	if err := d.Exec(ctx, createLockTable(d.Dialect()), []any{}, nil); err != nil {
+		return nil, fmt.Errorf("sql: creating lock table: %w", err)
+	}
+	insert, args := Dialect(d.Dialect()).
+		Insert(LockTable).
+		Columns("name").
+		Values(key).
+		OnConflict(DoNothing()).
+		Query()
+	for {
+		var res sql.Result
+		if err := d.Exec(ctx, insert, args, &res); err != nil {
+			return nil, fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if n == 1 {
+			break
+		}
+		if !wait {
+			return nil, ErrLocked
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockPollInterval):
+		}
+	}
+	return releaseOnce(func() error {
+		query, args := Dialect(d.Dialect()).
+			Delete(LockTable).
+			Where(EQ("name", key)).
+			Query()
+		return d.Exec(context.Background(), query, args, nil)
+	}), nil
+}
+
+// LockXact acquires a transaction-level advisory lock for the given key in the given
+// transaction. It waits until the lock is acquired or the context is done, and the lock
+// is released when the transaction is committed or rolled back. The transaction must be
+// started by a Driver, optionally wrapped by dialect.DebugDriver.
+//
+// On PostgreSQL, it is implemented using pg_advisory_xact_lock. On SQLite, the write lock
+// that is acquired by the transaction is used, and ErrLocked is returned if the key is held
+// by a session-level lock. On MySQL, it is implemented using GET_LOCK on the connection of
+// the transaction, and the lock is released on the same connection using RELEASE_LOCK only
+// after the transaction ends.
+func LockXact(ctx context.Context, tx dialect.Tx, key string) error {
+	t, ok := unwrapTx(tx)
+	if !ok {
+		return fmt.Errorf("sql: advisory locks require a transaction started by sql.Driver, got: %T", tx)
+	}
+	switch d := t.dialect; d {
+	case dialect.Postgres:
+		if err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", []any{lockID(key)}, nil); err != nil {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		return nil
+	case dialect.MySQL:
+		if t.conn == nil {
+			return fmt.Errorf("sql: transaction-level locks on %s require a pinned connection", d)
+		}
+		rows := &Rows{}
+		if err := tx.Query(ctx, "SELECT GET_LOCK(?, -1)", []any{lockName(key)}, rows); err != nil {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		defer rows.Close()
+		var acquired sql.NullBool
+		if err := ScanOne(rows, &acquired); err != nil {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		if !acquired.Valid || !acquired.Bool {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, ErrLocked)
+		}
+		// Releasing the lock before the transaction ends allows other
+		// sessions to acquire it before its changes are visible.
+		t.afterEnd(func(conn *sql.Conn) error {
+			_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName(key))
+			return err
+		})
+		return nil
+	case dialect.SQLite:
+		if err := tx.Exec(ctx, createLockTable(d), []any{}, nil); err != nil {
+			return fmt.Errorf("sql: creating lock table: %w", err)
+		}
+		// Writing to the database acquires its write lock until the end of the
+		// transaction. Hence, the inserted key is removed right after.
+		var res sql.Result
+		query, args := Dialect(d).
+			Insert(LockTable).
+			Columns("name").
+			Values(key).
+			OnConflict(DoNothing()).
+			Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return ErrLocked
+		}
+		query, args = Dialect(d).
+			Delete(LockTable).
+			Where(EQ("name", key)).
+			Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("sql: acquiring lock %q: %w", key, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("sql: advisory locks are not supported by %s", d)
+	}
+}
+
+// unwrapTx returns the *Tx of the given transaction, if it was started by a Driver.
+func unwrapTx(tx dialect.Tx) (*Tx, bool) {
+	switch tx := tx.(type) {
+	case *Tx:
+		return tx, true
+	case *dialect.DebugTx:
+		return unwrapTx(tx.Tx)
+	default:
+		return nil, false
+	}
+}
+
+// createLockTable returns the statement for creating the LockTable.
+func createLockTable(d string) string {
+	return Dialect(d).String(func(b *Builder) {
+		b.WriteString("CREATE TABLE IF NOT EXISTS ").Ident(LockTable).WriteString(" (").Ident("name").WriteString(" TEXT PRIMARY KEY)")
+	})
+}
+
+// lockID returns the 64-bit identifier of a PostgreSQL advisory lock.
+func lockID(key string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return int64(h.Sum64())
+}
+
+// lockName returns the name of a MySQL lock. Names are limited to 64 characters,
+// and therefore, longer keys are replaced with their hash.
+func lockName(key string) string {
+	if len(key) <= 64 {
+		return key
+	}
+	return fmt.Sprintf("%x", uint64(lockID(key)))
+}
+
+// releaseOnce ensures the given release function is executed at most once.
+func releaseOnce(release func() error) func() error {
+	var (
+		once sync.Once
+		err  error
+	)
+	return func() error {
+		once.Do(func() { err = release() })
+		return err
+	}
+}
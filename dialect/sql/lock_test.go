@@ -0,0 +1,160 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_Lock(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := OpenDB(dialect.Postgres, db)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT true FROM pg_advisory_lock($1)")).
+			WithArgs(lockID("cron")).
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}).AddRow(true))
+		mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).
+			WithArgs(lockID("cron")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		release, err := drv.Lock(ctx, "cron")
+		require.NoError(t, err)
+		require.NoError(t, release())
+		require.NoError(t, release(), "release should be executed once")
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_lock($1)")).
+			WithArgs(lockID("cron")).
+			WillReturnRows(sqlmock.NewRows([]string{"bool"}).AddRow(false))
+		_, err = drv.TryLock(ctx, "cron")
+		require.ErrorIs(t, err, ErrLocked)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := OpenDB(dialect.MySQL, db)
+		key := strings.Repeat("k", 65)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, 0)")).
+			WithArgs(lockName(key)).
+			WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+		mock.ExpectExec(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).
+			WithArgs(lockName(key)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		release, err := drv.TryLock(ctx, key)
+		require.NoError(t, err)
+		require.NoError(t, release())
+		require.Len(t, lockName(key), 16)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		drv := OpenDB(dialect.SQLite, db)
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `ent_locks` (`name` TEXT PRIMARY KEY)")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ent_locks` (`name`) VALUES (?) ON CONFLICT DO NOTHING")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		_, err = drv.TryLock(ctx, "cron")
+		require.ErrorIs(t, err, ErrLocked)
+
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `ent_locks` (`name` TEXT PRIMARY KEY)")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ent_locks` (`name`) VALUES (?) ON CONFLICT DO NOTHING")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ent_locks` (`name`) VALUES (?) ON CONFLICT DO NOTHING")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `ent_locks` WHERE `name` = ?")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		release, err := drv.Lock(ctx, "cron")
+		require.NoError(t, err)
+		require.NoError(t, release())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLockXact(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+			WithArgs(lockID("cron")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		tx, err := OpenDB(dialect.Postgres, db).Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, LockXact(ctx, tx, "cron"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		for _, end := range []struct {
+			name   string
+			expect func(sqlmock.Sqlmock)
+			end    func(dialect.Tx) error
+		}{
+			{"Commit", func(m sqlmock.Sqlmock) { m.ExpectCommit() }, dialect.Tx.Commit},
+			{"Rollback", func(m sqlmock.Sqlmock) { m.ExpectRollback() }, dialect.Tx.Rollback},
+		} {
+			t.Run(end.name, func(t *testing.T) {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, -1)")).
+					WithArgs("cron").
+					WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+				// The lock is released only after the transaction ends.
+				end.expect(mock)
+				mock.ExpectExec(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).
+					WithArgs("cron").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				tx, err := OpenDB(dialect.MySQL, db).Tx(ctx)
+				require.NoError(t, err)
+				require.NoError(t, LockXact(ctx, tx, "cron"))
+				require.NoError(t, end.end(tx))
+				require.NoError(t, mock.ExpectationsWereMet())
+			})
+		}
+		t.Run("Unpinned", func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			mock.ExpectBegin()
+			tx, err := OpenDB(dialect.Postgres, db).Tx(ctx)
+			require.NoError(t, err)
+			err = LockXact(ctx, &Tx{Conn: Conn{tx.(*Tx).Tx.(ExecQuerier), dialect.MySQL}, Tx: tx.(*Tx).Tx}, "cron")
+			require.Error(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `ent_locks` (`name` TEXT PRIMARY KEY)")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ent_locks` (`name`) VALUES (?) ON CONFLICT DO NOTHING")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `ent_locks` WHERE `name` = ?")).
+			WithArgs("cron").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		tx, err := OpenDB(dialect.SQLite, db).Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, LockXact(ctx, tx, "cron"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
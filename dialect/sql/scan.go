@@ -164,6 +164,7 @@ var (
 	timeType     = reflect.TypeOf(time.Time{})
 	scannerType  = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
 	nullJSONType = reflect.TypeOf((*nullJSON)(nil)).Elem()
+	nullTimeType = reflect.TypeOf((*nullTime)(nil))
 )
 
 // nullJSON represents a json.RawMessage that may be NULL.
@@ -178,6 +179,46 @@ func (j *nullJSON) Scan(v interface{}) error {
 	return nil
 }
 
+// nullTime represents a time.Time that may be NULL, and may be returned
+// as text by the database. For example, SQLite returns the result of date
+// and time functions as text.
+type nullTime time.Time
+
+// timeFormats are the text formats that are accepted by nullTime.
+var timeFormats = [...]string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *nullTime) Scan(v any) error {
+	var s string
+	switch v := v.(type) {
+	case time.Time:
+		*t = nullTime(v)
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("sql/scan: unsupported time value %T", v)
+	}
+	s = strings.TrimSuffix(s, "Z")
+	for _, f := range timeFormats {
+		if tv, err := time.ParseInLocation(f, s, time.UTC); err == nil {
+			*t = nullTime(tv)
+			return nil
+		}
+	}
+	return fmt.Errorf("sql/scan: invalid time value %q", s)
+}
+
 // assignable reports if the given type can be assigned directly by `Rows.Scan`.
 func assignable(typ reflect.Type) bool {
 	switch k := typ.Kind(); {
@@ -235,6 +276,10 @@ func scanStruct(typ reflect.Type, columns []string) (*rowScan, error) {
 		// convertAssign, assume it is a JSON field.
 		case !supportsScan(rtype):
 			rtype = nullJSONType
+		// Time values are scanned using nullTime
+		// to accept times that are returned as text.
+		case rtype == timeType:
+			rtype = nullTimeType
 		// Create a pointer to the actual reflect
 		// types to accept optional struct fields.
 		case !nillable(rtype):
@@ -263,6 +308,8 @@ func scanStruct(typ reflect.Type, columns []string) (*rowScan, error) {
 				if err := json.Unmarshal(rv.Bytes(), rvalue.Addr().Interface()); err != nil {
 					return reflect.Value{}, fmt.Errorf("unmarshal field %q: %w", ft.Name, err)
 				}
+			case rv.Type() == nullTimeType:
+				rvalue.Set(rv.Elem().Convert(timeType))
 			case !nillable(rvalue.Type()):
 				rv = reflect.Indirect(rv)
 				fallthrough
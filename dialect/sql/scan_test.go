@@ -9,6 +9,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -280,6 +281,29 @@ func TestScanSlicePtr(t *testing.T) {
 	require.Equal(t, "nati", **v2[1].Name)
 }
 
+func TestScanSliceTime(t *testing.T) {
+	now := time.Date(2024, 5, 6, 10, 11, 12, 0, time.UTC)
+	mock := sqlmock.NewRows([]string{"bucket", "count"}).
+		AddRow("2024-05-06 00:00:00", 1).
+		AddRow([]byte("2024-05-06T10:11:12Z"), 2).
+		AddRow(now, 3).
+		AddRow(nil, 4)
+	var v []struct {
+		Bucket time.Time
+		Count  int
+	}
+	require.NoError(t, ScanSlice(toRows(mock), &v))
+	require.Len(t, v, 4)
+	require.Equal(t, time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC), v[0].Bucket)
+	require.Equal(t, now, v[1].Bucket)
+	require.Equal(t, now, v[2].Bucket)
+	require.True(t, v[3].Bucket.IsZero())
+	require.Equal(t, 4, v[3].Count)
+
+	mock = sqlmock.NewRows([]string{"bucket"}).AddRow("yesterday")
+	require.EqualError(t, ScanSlice(toRows(mock), &v), `sql/scan: failed scanning rows: sql: Scan error on column index 0, name "bucket": sql/scan: invalid time value "yesterday"`)
+}
+
 func TestScanInt64(t *testing.T) {
 	mock := sqlmock.NewRows([]string{"age"}).
 		AddRow("10").
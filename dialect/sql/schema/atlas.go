@@ -89,7 +89,7 @@ func NewMigrateURL(u string, opts ...MigrateOption) (*Atlas, error) {
 		opt(a)
 	}
 	if a.dialect == "" {
-		a.dialect = parsed.Scheme
+		a.dialect = schemeDialect(parsed.Scheme)
 	}
 	if err := a.init(); err != nil {
 		return nil, err
@@ -853,7 +853,7 @@ func (a *Atlas) realm(tables []*Table) (*schema.Realm, error) {
 			sm[et.Schema] = schema.New(et.Schema)
 		}
 		s := sm[et.Schema]
-		if et.View {
+		if et.View && !a.emulatedView(et) {
 			if et.Annotation == nil || et.Annotation.ViewAs == "" && et.Annotation.ViewFor[a.dialect] == "" {
 				continue // defined externally
 			}
@@ -868,6 +868,12 @@ func (a *Atlas) realm(tables []*Table) (*schema.Realm, error) {
 			if err := a.aVColumns(et, av); err != nil {
 				return nil, err
 			}
+			if et.Annotation.Materialized {
+				av.SetMaterialized(true)
+				if err := a.aVIndexes(et, av); err != nil {
+					return nil, err
+				}
+			}
 			s.AddViews(av)
 			continue
 		}
@@ -1004,6 +1010,32 @@ func (a *Atlas) aVColumns(et *Table, at *schema.View) error {
 	return nil
 }
 
+// aVIndexes adds the indexes of a materialized view.
+func (a *Atlas) aVIndexes(et *Table, av *schema.View) error {
+	// Indexes are built on a temporary table that shares
+	// the view columns, and then attached to the view.
+	at := schema.NewTable(av.Name).AddColumns(av.Columns...)
+	for _, idx1 := range et.Indexes {
+		idx2 := schema.NewIndex(idx1.Name).
+			SetUnique(idx1.Unique)
+		if err := a.sqlDialect.atIndex(idx1, at, idx2); err != nil {
+			return err
+		}
+		desc := descIndexes(idx1)
+		for _, p := range idx2.Parts {
+			p.Desc = desc[p.C.Name]
+		}
+		av.AddIndexes(idx2)
+	}
+	return nil
+}
+
+// emulatedView reports if the given view is a materialized view that is emulated
+// using a table, because the dialect does not support materialized views.
+func (a *Atlas) emulatedView(et *Table) bool {
+	return et.View && et.Annotation != nil && et.Annotation.Materialized && a.dialect != dialect.Postgres
+}
+
 func (a *Atlas) atDefault(c1 *Column, c2 *schema.Column) error {
 	if c1.Default == nil || !a.sqlDialect.supportsDefault(c1) {
 		return nil
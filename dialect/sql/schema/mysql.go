@@ -196,6 +196,19 @@ func (d *MySQL) atTypeC(c1 *Column, c2 *schema.Column) error {
 		if _, maria := d.mariadb(); maria || compareVersions(d.version, "8.0.2") == -1 && c1.Default == nil {
 			c2.SetNull(c1.Attr == "")
 		}
+	case field.TypeDate:
+		t = &schema.TimeType{T: mysql.TypeDate}
+	case field.TypeTimeOfDay:
+		t = &schema.TimeType{T: mysql.TypeTime}
+	case field.TypeDuration:
+		// Durations are stored as nanoseconds.
+		t = &schema.IntegerType{T: mysql.TypeBigInt}
+	case field.TypeIP:
+		t = &schema.StringType{T: mysql.TypeVarchar, Size: 45}
+	case field.TypePrefix:
+		t = &schema.StringType{T: mysql.TypeVarchar, Size: 49}
+	case field.TypeMAC:
+		t = &schema.StringType{T: mysql.TypeVarchar, Size: 17}
 	case field.TypeEnum:
 		t = &schema.EnumType{T: mysql.TypeEnum, Values: c1.Enums}
 	case field.TypeUUID:
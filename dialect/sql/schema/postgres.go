@@ -138,6 +138,18 @@ func (d *Postgres) atTypeC(c1 *Column, c2 *schema.Column) error {
 		}
 	case field.TypeTime:
 		t = &schema.TimeType{T: c1.scanTypeOr(postgres.TypeTimestampWTZ)}
+	case field.TypeDate:
+		t = &schema.TimeType{T: postgres.TypeDate}
+	case field.TypeTimeOfDay:
+		t = &schema.TimeType{T: postgres.TypeTime}
+	case field.TypeDuration:
+		t = &postgres.IntervalType{T: postgres.TypeInterval}
+	case field.TypeIP:
+		t = &postgres.NetworkType{T: postgres.TypeInet}
+	case field.TypePrefix:
+		t = &postgres.NetworkType{T: postgres.TypeCIDR}
+	case field.TypeMAC:
+		t = &postgres.NetworkType{T: postgres.TypeMACAddr}
 	case field.TypeEnum:
 		// Although atlas supports enum types, we keep backwards compatibility
 		// with previous versions of ent and use varchar (see cType).
@@ -215,6 +227,7 @@ func indexOpClass(idx *Index) map[string]string {
 
 func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) error {
 	opc := indexOpClass(idx1)
+	t, hasT := indexType(idx1, dialect.Postgres)
 	for _, c1 := range idx1.Columns {
 		c2, ok := t2.Column(c1.Name)
 		if !ok {
@@ -227,10 +240,13 @@ func (d *Postgres) atIndex(idx1 *Index, t2 *schema.Table, idx2 *schema.Index) er
 				return fmt.Errorf("unmarshalling operator-class %q for column %q: %v", v, c1.Name, err)
 			}
 			part.Attrs = append(part.Attrs, &op)
+		} else if (c1.Type == field.TypeIP || c1.Type == field.TypePrefix) && strings.EqualFold(t, "gist") {
+			// Network types do not have a default operator class for GiST indexes.
+			part.Attrs = append(part.Attrs, &postgres.IndexOpClass{Name: "inet_ops"})
 		}
 		idx2.AddParts(part)
 	}
-	if t, ok := indexType(idx1, dialect.Postgres); ok {
+	if hasT {
 		idx2.AddAttrs(&postgres.IndexType{T: t})
 	}
 	if ant, supportsInclude := idx1.Annotation, compareVersions(d.version, "11.0.0") >= 0; ant != nil && len(ant.IncludeColumns) > 0 && supportsInclude {
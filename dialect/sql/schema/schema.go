@@ -212,6 +212,7 @@ func CopyTables(tables []*Table) ([]*Table, error) {
 	for i, t := range tables {
 		copyT[i] = &Table{
 			Name:        t.Name,
+			View:        t.View,
 			Columns:     make([]*Column, len(t.Columns)),
 			Indexes:     make([]*Index, len(t.Indexes)),
 			ForeignKeys: make([]*ForeignKey, len(t.ForeignKeys)),
@@ -412,7 +413,8 @@ func (c Column) supportDefault() bool {
 	switch t := c.Type; t {
 	case field.TypeString, field.TypeEnum:
 		return c.Size < 1<<16 // not a text.
-	case field.TypeBool, field.TypeTime, field.TypeUUID:
+	case field.TypeBool, field.TypeTime, field.TypeUUID, field.TypeDate, field.TypeTimeOfDay, field.TypeDuration,
+		field.TypeIP, field.TypePrefix, field.TypeMAC:
 		return true
 	default:
 		return t.Numeric()
@@ -650,9 +652,11 @@ func DDL(ctx context.Context, args DDLArgs) (string, error) {
 		return "", err
 	}
 	for _, v := range vs {
-		q, _ := sql.Dialect(args.Dialect).
-			CreateView(v.Name).
-			Schema(v.Schema.Name).
+		b := sql.Dialect(args.Dialect).CreateView(v.Name)
+		if v.Materialized() {
+			b.Materialized()
+		}
+		q, _ := b.Schema(v.Schema.Name).
 			Columns(func(cols []*schema.Column) (bs []*sql.ColumnBuilder) {
 				for _, c := range cols {
 					bs = append(bs, sql.Dialect(args.Dialect).Column(c.Name).Type(c.Type.Raw))
@@ -665,6 +669,12 @@ func DDL(ctx context.Context, args DDLArgs) (string, error) {
 			Cmd:     q,
 			Comment: fmt.Sprintf("Add %q view", v.Name),
 		})
+		for _, idx := range v.Indexes {
+			p.Changes = append(p.Changes, &migrate.Change{
+				Cmd:     viewIndex(args.Dialect, v, idx),
+				Comment: fmt.Sprintf("Create index %q to view %q", idx.Name, v.Name),
+			})
+		}
 	}
 	for _, t := range args.Tables {
 		p.Directives = append(p.Directives, fmt.Sprintf(
@@ -677,10 +687,14 @@ func DDL(ctx context.Context, args DDLArgs) (string, error) {
 			}(),
 			t.Name,
 			func() string {
-				if t.View {
+				switch {
+				case !t.View || a.emulatedView(t):
+					return "table"
+				case t.Annotation != nil && t.Annotation.Materialized:
+					return "materialized"
+				default:
 					return "view"
 				}
-				return "table"
 			}(),
 			t.Pos,
 		))
@@ -691,3 +705,29 @@ func DDL(ctx context.Context, args DDLArgs) (string, error) {
 	}
 	return string(f.Bytes()), nil
 }
+
+// viewIndex returns the statement for creating an index on a materialized view.
+func viewIndex(d string, v *schema.View, idx *schema.Index) string {
+	b := &sql.Builder{}
+	b.SetDialect(d)
+	b.WriteString("CREATE ")
+	if idx.Unique {
+		b.WriteString("UNIQUE ")
+	}
+	b.WriteString("INDEX ").Ident(idx.Name).WriteString(" ON ")
+	if v.Schema.Name != "" {
+		b.Ident(v.Schema.Name).WriteByte('.')
+	}
+	b.Ident(v.Name).Pad().Wrap(func(b *sql.Builder) {
+		for i, p := range idx.Parts {
+			if i > 0 {
+				b.Comma()
+			}
+			b.Ident(p.C.Name)
+			if p.Desc {
+				b.WriteString(" DESC")
+			}
+		}
+	})
+	return b.String()
+}
@@ -15,6 +15,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,6 +112,103 @@ func TestColumn_ScanDefault(t *testing.T) {
 	require.Equal(t, "00000000-0000-0000-0000-000000000000", c1.Default)
 }
 
+func TestColumn_CivilTypes(t *testing.T) {
+	for _, tt := range []struct {
+		d interface {
+			atTypeC(*Column, *schema.Column) error
+		}
+		want map[field.Type]schema.Type
+	}{
+		{
+			d: &MySQL{version: "8.0.19"},
+			want: map[field.Type]schema.Type{
+				field.TypeDate:      &schema.TimeType{T: mysql.TypeDate},
+				field.TypeTimeOfDay: &schema.TimeType{T: mysql.TypeTime},
+				field.TypeDuration:  &schema.IntegerType{T: mysql.TypeBigInt},
+			},
+		},
+		{
+			d: &Postgres{},
+			want: map[field.Type]schema.Type{
+				field.TypeDate:      &schema.TimeType{T: postgres.TypeDate},
+				field.TypeTimeOfDay: &schema.TimeType{T: postgres.TypeTime},
+				field.TypeDuration:  &postgres.IntervalType{T: postgres.TypeInterval},
+			},
+		},
+		{
+			d: &SQLite{},
+			want: map[field.Type]schema.Type{
+				field.TypeDate:      &schema.TimeType{T: "date"},
+				field.TypeTimeOfDay: &schema.TimeType{T: "time"},
+				field.TypeDuration:  &schema.IntegerType{T: sqlite.TypeInteger},
+			},
+		},
+	} {
+		for typ, want := range tt.want {
+			c := &schema.Column{Name: "c", Type: &schema.ColumnType{}}
+			require.NoError(t, tt.d.atTypeC(&Column{Name: "c", Type: typ}, c))
+			require.Equal(t, want, c.Type.Type)
+		}
+	}
+	require.True(t, Column{Type: field.TypeDate}.supportDefault())
+	require.True(t, Column{Type: field.TypeDuration}.supportDefault())
+}
+
+func TestColumn_NetTypes(t *testing.T) {
+	for _, tt := range []struct {
+		d interface {
+			atTypeC(*Column, *schema.Column) error
+		}
+		want map[field.Type]schema.Type
+	}{
+		{
+			d: &MySQL{version: "8.0.19"},
+			want: map[field.Type]schema.Type{
+				field.TypeIP:     &schema.StringType{T: mysql.TypeVarchar, Size: 45},
+				field.TypePrefix: &schema.StringType{T: mysql.TypeVarchar, Size: 49},
+				field.TypeMAC:    &schema.StringType{T: mysql.TypeVarchar, Size: 17},
+			},
+		},
+		{
+			d: &Postgres{},
+			want: map[field.Type]schema.Type{
+				field.TypeIP:     &postgres.NetworkType{T: postgres.TypeInet},
+				field.TypePrefix: &postgres.NetworkType{T: postgres.TypeCIDR},
+				field.TypeMAC:    &postgres.NetworkType{T: postgres.TypeMACAddr},
+			},
+		},
+		{
+			d: &SQLite{},
+			want: map[field.Type]schema.Type{
+				field.TypeIP:     &schema.StringType{T: sqlite.TypeText},
+				field.TypePrefix: &schema.StringType{T: sqlite.TypeText},
+				field.TypeMAC:    &schema.StringType{T: sqlite.TypeText},
+			},
+		},
+	} {
+		for typ, want := range tt.want {
+			c := &schema.Column{Name: "c", Type: &schema.ColumnType{}}
+			require.NoError(t, tt.d.atTypeC(&Column{Name: "c", Type: typ}, c))
+			require.Equal(t, want, c.Type.Type)
+		}
+	}
+
+	// GiST indexes on network columns use the inet_ops operator class by default.
+	c1, c2 := &Column{Name: "subnet", Type: field.TypePrefix}, &Column{Name: "name", Type: field.TypeString}
+	t2 := schema.NewTable("hosts").AddColumns(schema.NewColumn("subnet"), schema.NewColumn("name"))
+	idx2 := schema.NewIndex("hosts_subnet")
+	err := (&Postgres{}).atIndex(&Index{Name: "hosts_subnet", Columns: []*Column{c1, c2}, Annotation: entsql.IndexType("GIST")}, t2, idx2)
+	require.NoError(t, err)
+	require.Equal(t, []schema.Attr{&postgres.IndexOpClass{Name: "inet_ops"}}, idx2.Parts[0].Attrs)
+	require.Empty(t, idx2.Parts[1].Attrs)
+	require.Equal(t, []schema.Attr{&postgres.IndexType{T: "GIST"}}, idx2.Attrs)
+
+	idx2 = schema.NewIndex("hosts_subnet")
+	err = (&Postgres{}).atIndex(&Index{Name: "hosts_subnet", Columns: []*Column{c1}}, t2, idx2)
+	require.NoError(t, err)
+	require.Empty(t, idx2.Parts[0].Attrs)
+}
+
 func TestCopyTables(t *testing.T) {
 	users := &Table{
 		Name: "users",
@@ -153,6 +254,14 @@ func TestCopyTables(t *testing.T) {
 	copyT, err := CopyTables(tables)
 	require.NoError(t, err)
 	require.Equal(t, tables, copyT)
+
+	stats := NewView("pet_stats").
+		AddColumn(&Column{Name: "owner_id", Type: field.TypeInt}).
+		SetAnnotation(entsql.MaterializedView("SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id"))
+	copyT, err = CopyTables([]*Table{stats})
+	require.NoError(t, err)
+	require.True(t, copyT[0].View)
+	require.Equal(t, stats.Annotation, copyT[0].Annotation)
 }
 
 func TestDDL(t *testing.T) {
@@ -388,3 +497,51 @@ CREATE VIEW $pets_without_fur$ ($id$, $name$, $owner_id$) AS SELECT id, name, ow
 		})
 	}
 }
+
+func TestDDL_MaterializedView(t *testing.T) {
+	stats := &Table{
+		Name: "pet_stats",
+		Pos:  "stats.go:10",
+		View: true,
+		Columns: []*Column{
+			{Name: "owner_id", Type: field.TypeInt},
+			{Name: "pets", Type: field.TypeInt},
+		},
+		Annotation: entsql.MaterializedView("SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id"),
+	}
+	stats.Indexes = append(stats.Indexes, &Index{
+		Name:    "petstats_owner_id",
+		Unique:  true,
+		Columns: stats.Columns[:1],
+	})
+	ac, err := DDL(context.Background(), DDLArgs{
+		Dialect: dialect.Postgres,
+		Version: "15",
+		Tables:  []*Table{stats},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `-- atlas:pos pet_stats[type=materialized] stats.go:10
+
+-- Add "pet_stats" view
+CREATE MATERIALIZED VIEW "pet_stats" ("owner_id", "pets") AS SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id;
+-- Create index "petstats_owner_id" to view "pet_stats"
+CREATE UNIQUE INDEX "petstats_owner_id" ON "pet_stats" ("owner_id");
+`, ac)
+
+	// Materialized views are emulated using tables on other dialects.
+	ac, err = DDL(context.Background(), DDLArgs{
+		Dialect: dialect.SQLite,
+		Tables:  []*Table{stats},
+	})
+	require.NoError(t, err)
+	require.Equal(t, strings.ReplaceAll(`-- atlas:pos pet_stats[type=table] stats.go:10
+
+-- Create "pet_stats" table
+CREATE TABLE $pet_stats$ (
+  $owner_id$ integer NOT NULL,
+  $pets$ integer NOT NULL
+);
+-- Create index "petstats_owner_id" to table: "pet_stats"
+CREATE UNIQUE INDEX $petstats_owner_id$ ON $pet_stats$ ($owner_id$);
+`, "$", "`"), ac)
+}
@@ -129,6 +129,15 @@ func (d *SQLite) atTypeC(c1 *Column, c2 *schema.Column) error {
 		t = &schema.FloatType{T: sqlite.TypeReal}
 	case field.TypeTime:
 		t = &schema.TimeType{T: "datetime"}
+	case field.TypeDate:
+		t = &schema.TimeType{T: "date"}
+	case field.TypeTimeOfDay:
+		t = &schema.TimeType{T: "time"}
+	case field.TypeDuration:
+		// Durations are stored as nanoseconds.
+		t = &schema.IntegerType{T: sqlite.TypeInteger}
+	case field.TypeIP, field.TypePrefix, field.TypeMAC:
+		t = &schema.StringType{T: sqlite.TypeText}
 	case field.TypeJSON:
 		t = &schema.JSONType{T: "json"}
 	case field.TypeUUID:
@@ -0,0 +1,418 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlcheck"
+	"ariga.io/atlas/sql/sqlclient"
+
+	// Register the migration analyzers of the supported dialects.
+	_ "ariga.io/atlas/sql/mysql/mysqlcheck"
+	_ "ariga.io/atlas/sql/postgres/postgrescheck"
+	_ "ariga.io/atlas/sql/sqlite/sqlitecheck"
+)
+
+// RevisionsTable defines the table name holding the revisions of the applied migration files.
+const RevisionsTable = "ent_schema_revisions"
+
+// revisionColumns holds the columns of the RevisionsTable in the order they are read and written.
+var revisionColumns = []string{
+	"version", "description", "type", "applied", "total", "executed_at",
+	"execution_time", "error", "error_stmt", "hash", "partial_hashes", "operator_version",
+}
+
+// NewRevisionsTable returns a new table for holding the revisions of the applied migration files.
+func NewRevisionsTable() *Table {
+	return NewTable(RevisionsTable).
+		AddPrimary(&Column{Name: "version", Type: field.TypeString}).
+		AddColumn(&Column{Name: "description", Type: field.TypeString}).
+		AddColumn(&Column{Name: "type", Type: field.TypeUint}).
+		AddColumn(&Column{Name: "applied", Type: field.TypeInt}).
+		AddColumn(&Column{Name: "total", Type: field.TypeInt}).
+		AddColumn(&Column{Name: "executed_at", Type: field.TypeTime}).
+		AddColumn(&Column{Name: "execution_time", Type: field.TypeInt64}).
+		AddColumn(&Column{Name: "error", Type: field.TypeString, Size: math.MaxInt32, Nullable: true}).
+		AddColumn(&Column{Name: "error_stmt", Type: field.TypeString, Size: math.MaxInt32, Nullable: true}).
+		AddColumn(&Column{Name: "hash", Type: field.TypeString}).
+		AddColumn(&Column{Name: "partial_hashes", Type: field.TypeJSON, Nullable: true}).
+		AddColumn(&Column{Name: "operator_version", Type: field.TypeString})
+}
+
+type (
+	// MigrateStatus describes the state of a database in regard to a migration directory.
+	MigrateStatus struct {
+		Current string              `json:"current,omitempty"` // version of the last applied revision
+		Applied []*migrate.Revision `json:"applied,omitempty"` // revisions stored in the database
+		Pending []string            `json:"pending,omitempty"` // names of the pending migration files
+	}
+
+	// LintFile describes the analysis of a migration file.
+	LintFile struct {
+		Name    string            `json:"name"`              // name of the migration file
+		Reports []sqlcheck.Report `json:"reports,omitempty"` // reports of the analyzers
+		Errors  []string          `json:"errors,omitempty"`  // errors of analyzers that fail on their reports
+	}
+)
+
+// Apply executes the first n pending files of the migration directory on the database connected by the given
+// URL and records them in the RevisionsTable. If n is zero, all pending files are executed. The names of the
+// executed files are returned, also in case one of them fails.
+func Apply(ctx context.Context, u string, dir migrate.Dir, n int, opts ...migrate.ExecutorOption) (applied []string, err error) {
+	c, rrw, err := openRevisions(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	ex, err := migrate.NewExecutor(c.Driver, dir, rrw, opts...)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := ex.Pending(ctx)
+	switch {
+	case errors.Is(err, migrate.ErrNoPendingFiles):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case n > 0 && n < len(pending):
+		pending = pending[:n]
+	}
+	// The table is created only after the database was checked to
+	// be clean, or baselined, as it would make it dirty otherwise.
+	if err := rrw.init(ctx); err != nil {
+		return nil, err
+	}
+	for _, f := range pending {
+		if err := ex.Execute(ctx, f); err != nil {
+			return applied, err
+		}
+		applied = append(applied, f.Name())
+	}
+	return applied, nil
+}
+
+// Status returns the applied revisions and the pending files of the migration
+// directory for the database connected by the given URL.
+func Status(ctx context.Context, u string, dir migrate.Dir) (*MigrateStatus, error) {
+	c, rrw, err := openRevisions(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	ex, err := migrate.NewExecutor(c.Driver, dir, rrw)
+	if err != nil {
+		return nil, err
+	}
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &MigrateStatus{Applied: revs}
+	if len(revs) > 0 {
+		s.Current = revs[len(revs)-1].Version
+	}
+	pending, err := ex.Pending(ctx)
+	switch {
+	case errors.Is(err, migrate.ErrNoPendingFiles):
+	case err != nil:
+		return nil, err
+	default:
+		for _, f := range pending {
+			s.Pending = append(s.Pending, f.Name())
+		}
+	}
+	return s, nil
+}
+
+// Lint analyzes the latest n files of the migration directory with the analyzers registered for the dialect
+// of the dev database (e.g. destructive or backward-incompatible changes). The files preceding them are replayed
+// on the dev database first, and the database is cleaned when the analysis ends. If n is zero, all files are analyzed.
+func Lint(ctx context.Context, devURL string, dir migrate.Dir, n int) (_ []*LintFile, err error) {
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("validating migration directory: %w", err)
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return nil, err
+	}
+	if n <= 0 || n > len(files) {
+		n = len(files)
+	}
+	dev, err := sqlclient.Open(ctx, devURL)
+	if err != nil {
+		return nil, err
+	}
+	defer dev.Close()
+	if err := dev.CheckClean(ctx, nil); err != nil {
+		return nil, err
+	}
+	name := dev.Name
+	// MariaDB shares the analyzers of MySQL.
+	if name == "mariadb" {
+		name = dialect.MySQL
+	}
+	azs, err := sqlcheck.AnalyzerFor(name, nil)
+	if err != nil {
+		return nil, err
+	}
+	ex, err := migrate.NewExecutor(dev.Driver, dir, migrate.NopRevisionReadWriter{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = (&Atlas{atDriver: dev.Driver}).cleanSchema(ctx, dev.URL.Schema, err)
+	}()
+	if err := ex.ExecuteFiles(ctx, files[:len(files)-n]); err != nil {
+		return nil, err
+	}
+	lints := make([]*LintFile, 0, n)
+	for _, f := range files[len(files)-n:] {
+		l, err := lintFile(ctx, dev, azs, f)
+		if err != nil {
+			return nil, err
+		}
+		lints = append(lints, l)
+	}
+	return lints, nil
+}
+
+// lintFile executes the statements of the given file on the dev database
+// one by one to collect their changes, and runs the analyzers on them.
+func lintFile(ctx context.Context, dev *sqlclient.Client, azs []sqlcheck.Analyzer, f migrate.File) (*LintFile, error) {
+	stmts, err := migrate.FileStmtDecls(dev, f)
+	if err != nil {
+		return nil, fmt.Errorf("scanning statements of %s: %w", f.Name(), err)
+	}
+	from, err := dev.InspectRealm(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	file, current := &sqlcheck.File{File: f, From: from}, from
+	for _, s := range stmts {
+		if _, err := dev.ExecContext(ctx, s.Text); err != nil {
+			return nil, fmt.Errorf("executing statement %q of %s: %w", s.Text, f.Name(), err)
+		}
+		next, err := dev.InspectRealm(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := dev.RealmDiff(current, next)
+		if err != nil {
+			return nil, err
+		}
+		file.Changes = append(file.Changes, &sqlcheck.Change{Stmt: s, Changes: changes})
+		current = next
+	}
+	if file.Sum, err = dev.RealmDiff(from, current); err != nil {
+		return nil, err
+	}
+	file.To = current
+	l := &LintFile{Name: f.Name()}
+	pass := &sqlcheck.Pass{
+		File: file,
+		Dev:  dev,
+		Reporter: sqlcheck.ReportWriterFunc(func(r sqlcheck.Report) {
+			l.Reports = append(l.Reports, r)
+		}),
+	}
+	for _, az := range azs {
+		if err := az.Analyze(ctx, pass); err != nil {
+			l.Errors = append(l.Errors, err.Error())
+		}
+	}
+	return l, nil
+}
+
+// openRevisions opens a client for the given database URL and
+// returns it alongside the revisions stored in the database.
+func openRevisions(ctx context.Context, u string) (*sqlclient.Client, *revisions, error) {
+	c, err := sqlclient.Open(ctx, u)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, &revisions{
+		at:     c.Driver,
+		drv:    entsql.OpenDB(schemeDialect(c.URL.Scheme), c.DB),
+		schema: c.URL.Schema,
+	}, nil
+}
+
+// revisions implements the migrate.RevisionReadWriter
+// interface using the RevisionsTable of the database.
+type revisions struct {
+	at      migrate.Driver
+	drv     dialect.Driver
+	schema  string
+	created bool
+}
+
+// Ident implements the migrate.RevisionReadWriter interface.
+func (r *revisions) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: RevisionsTable, Schema: r.schema}
+}
+
+// ReadRevisions implements the migrate.RevisionReadWriter interface.
+func (r *revisions) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	exists, err := r.exists(ctx)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return r.query(ctx, nil)
+}
+
+// ReadRevision implements the migrate.RevisionReadWriter interface.
+func (r *revisions) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	exists, err := r.exists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	revs, err := r.query(ctx, entsql.EQ("version", version))
+	if err != nil {
+		return nil, err
+	}
+	if len(revs) == 0 {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	return revs[0], nil
+}
+
+// WriteRevision implements the migrate.RevisionReadWriter interface.
+func (r *revisions) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	hashes, err := json.Marshal(rev.PartialHashes)
+	if err != nil {
+		return err
+	}
+	query, args := entsql.Dialect(r.drv.Dialect()).
+		Insert(RevisionsTable).
+		Columns(revisionColumns...).
+		Values(
+			rev.Version, rev.Description, uint(rev.Type), rev.Applied, rev.Total, rev.ExecutedAt,
+			int64(rev.ExecutionTime), rev.Error, rev.ErrorStmt, rev.Hash, string(hashes), rev.OperatorVersion,
+		).
+		OnConflict(entsql.ConflictColumns("version"), entsql.ResolveWithNewValues()).
+		Query()
+	if err := r.drv.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("write revision %q: %w", rev.Version, err)
+	}
+	return nil
+}
+
+// DeleteRevision implements the migrate.RevisionReadWriter interface.
+func (r *revisions) DeleteRevision(ctx context.Context, version string) error {
+	query, args := entsql.Dialect(r.drv.Dialect()).
+		Delete(RevisionsTable).
+		Where(entsql.EQ("version", version)).
+		Query()
+	return r.drv.Exec(ctx, query, args, nil)
+}
+
+// init creates the RevisionsTable, if it was not created before.
+func (r *revisions) init(ctx context.Context) error {
+	if r.created {
+		return nil
+	}
+	m, err := NewMigrate(r.drv)
+	if err != nil {
+		return err
+	}
+	if err := m.Create(ctx, NewRevisionsTable()); err != nil {
+		return fmt.Errorf("create revisions table: %w", err)
+	}
+	r.created = true
+	return nil
+}
+
+// exists reports if the RevisionsTable exists in the database.
+func (r *revisions) exists(ctx context.Context) (bool, error) {
+	if r.created {
+		return true, nil
+	}
+	s, err := r.at.InspectSchema(ctx, r.schema, &schema.InspectOptions{Tables: []string{RevisionsTable}})
+	if err != nil {
+		if schema.IsNotExistError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	_, r.created = s.Table(RevisionsTable)
+	return r.created, nil
+}
+
+// query the revisions matching the given predicate, ordered by their version.
+func (r *revisions) query(ctx context.Context, p *entsql.Predicate) ([]*migrate.Revision, error) {
+	selector := entsql.Dialect(r.drv.Dialect()).
+		Select(revisionColumns...).
+		From(entsql.Table(RevisionsTable)).
+		OrderBy("version")
+	if p != nil {
+		selector.Where(p)
+	}
+	rows := &entsql.Rows{}
+	query, args := selector.Query()
+	if err := r.drv.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("query revisions table: %w", err)
+	}
+	defer rows.Close()
+	var revs []*migrate.Revision
+	for rows.Next() {
+		var (
+			typ              uint
+			execTime         int64
+			errText, errStmt stdsql.NullString
+			hashes           []byte
+			rev              = &migrate.Revision{}
+		)
+		if err := rows.Scan(
+			&rev.Version, &rev.Description, &typ, &rev.Applied, &rev.Total, &rev.ExecutedAt,
+			&execTime, &errText, &errStmt, &rev.Hash, &hashes, &rev.OperatorVersion,
+		); err != nil {
+			return nil, fmt.Errorf("scan revision: %w", err)
+		}
+		rev.Type, rev.ExecutionTime = migrate.RevisionType(typ), time.Duration(execTime)
+		rev.Error, rev.ErrorStmt = errText.String, errStmt.String
+		if len(hashes) > 0 {
+			if err := json.Unmarshal(hashes, &rev.PartialHashes); err != nil {
+				return nil, fmt.Errorf("decode partial hashes of revision %q: %w", rev.Version, err)
+			}
+		}
+		revs = append(revs, rev)
+	}
+	return revs, rows.Err()
+}
+
+// schemeDialect returns the Ent dialect of the given database URL scheme
+// (e.g. "sqlite" or "maria+unix"), or the scheme itself if it is unknown.
+func schemeDialect(scheme string) string {
+	switch s, _, _ := strings.Cut(scheme, "+"); s {
+	case "sqlite", dialect.SQLite:
+		return dialect.SQLite
+	case dialect.MySQL, "maria", "mariadb":
+		return dialect.MySQL
+	case dialect.Postgres, "postgresql":
+		return dialect.Postgres
+	default:
+		return scheme
+	}
+}
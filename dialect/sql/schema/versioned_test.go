@@ -0,0 +1,116 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersioned(t *testing.T) {
+	ctx := context.Background()
+	dir, err := migrate.NewLocalDir(t.TempDir())
+	require.NoError(t, err)
+	var (
+		devURL = "sqlite://dev?mode=memory&_fk=1"
+		url    = "sqlite://" + filepath.Join(t.TempDir(), "ent.db") + "?_fk=1"
+		users  = &Table{
+			Name:    "users",
+			Columns: []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "name", Type: field.TypeString}},
+		}
+	)
+	users.PrimaryKey = users.Columns[:1]
+	err = Diff(ctx, devURL, "init", []*Table{users}, WithDir(dir), WithMigrationMode(ModeReplay), WithFormatter(migrate.DefaultFormatter))
+	require.NoError(t, err)
+	files, err := dir.Files()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	s, err := Status(ctx, url, dir)
+	require.NoError(t, err)
+	require.Empty(t, s.Current)
+	require.Empty(t, s.Applied)
+	require.Equal(t, []string{files[0].Name()}, s.Pending)
+
+	applied, err := Apply(ctx, url, dir, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{files[0].Name()}, applied)
+	applied, err = Apply(ctx, url, dir, 0)
+	require.NoError(t, err)
+	require.Empty(t, applied)
+
+	s, err = Status(ctx, url, dir)
+	require.NoError(t, err)
+	require.Equal(t, files[0].Version(), s.Current)
+	require.Len(t, s.Applied, 1)
+	require.Equal(t, 1, s.Applied[0].Applied)
+	require.Equal(t, s.Applied[0].Total, s.Applied[0].Applied)
+	require.Empty(t, s.Pending)
+
+	// Dropping a column is reported as a destructive change.
+	drop := time.Now().UTC().Add(time.Minute).Format("20060102150405") + "_drop.sql"
+	require.NoError(t, dir.WriteFile(drop, []byte("ALTER TABLE `users` DROP COLUMN `name`;\n")))
+	sum, err := dir.Checksum()
+	require.NoError(t, err)
+	require.NoError(t, migrate.WriteSumFile(dir, sum))
+	lints, err := Lint(ctx, devURL, dir, 1)
+	require.NoError(t, err)
+	require.Len(t, lints, 1)
+	require.Equal(t, drop, lints[0].Name)
+	require.NotEmpty(t, lints[0].Errors)
+	var codes []string
+	for _, r := range lints[0].Reports {
+		for _, d := range r.Diagnostics {
+			codes = append(codes, d.Code)
+		}
+	}
+	require.Contains(t, codes, "DS103")
+
+	// The dev database is cleaned after linting.
+	lints, err = Lint(ctx, devURL, dir, 0)
+	require.NoError(t, err)
+	require.Len(t, lints, 2)
+
+	s, err = Status(ctx, url, dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{drop}, s.Pending)
+	applied, err = Apply(ctx, url, dir, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{drop}, applied)
+
+	// Applying on a dirty database requires a baseline.
+	path := filepath.Join(t.TempDir(), "dirty.db")
+	db, err := sql.Open("sqlite3", "file:"+path+"?_fk=1")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	_, err = Apply(ctx, "sqlite://"+path+"?_fk=1", dir, 0)
+	require.ErrorContains(t, err, "baseline version or allow-dirty is required")
+	applied, err = Apply(ctx, "sqlite://"+path+"?_fk=1", dir, 0, migrate.WithBaselineVersion(files[0].Version()))
+	require.NoError(t, err)
+	require.Equal(t, []string{drop}, applied)
+}
+
+func TestSchemeDialect(t *testing.T) {
+	for scheme, d := range map[string]string{
+		"sqlite":     "sqlite3",
+		"sqlite3":    "sqlite3",
+		"mysql":      "mysql",
+		"maria+unix": "mysql",
+		"postgresql": "postgres",
+		"docker":     "docker",
+	} {
+		require.Equal(t, d, schemeDialect(scheme), scheme)
+	}
+}
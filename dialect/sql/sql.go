@@ -6,6 +6,7 @@ package sql
 
 import (
 	"fmt"
+	"net/netip"
 	"strings"
 
 	"entgo.io/ent/dialect"
@@ -191,6 +192,34 @@ func FieldContainsFold(name string, substr string) func(*Selector) {
 	}
 }
 
+// FieldIPIn returns a raw predicate to check if the IP address field is contained within the given prefix.
+func FieldIPIn(name string, prefix netip.Prefix) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(IPIn(s.C(name), prefix))
+	}
+}
+
+// FieldPrefixContains returns a raw predicate to check if the prefix field contains the given IP address.
+func FieldPrefixContains(name string, addr netip.Addr) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(PrefixContains(s.C(name), addr))
+	}
+}
+
+// FieldOverlaps returns a raw predicate to check if the prefix field overlaps with the given prefix.
+func FieldOverlaps(name string, prefix netip.Prefix) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(Overlaps(s.C(name), prefix))
+	}
+}
+
+// FieldDaysSince returns a raw predicate to compare the number of days between the date field and the given date.
+func FieldDaysSince(name string, v any, op Op, n int) func(*Selector) {
+	return func(s *Selector) {
+		s.Where(DaysSince(s.C(name), v, op, n))
+	}
+}
+
 // AndPredicates returns a new predicate for joining multiple generated predicates with AND between them.
 func AndPredicates[P ~func(*Selector)](predicates ...P) func(*Selector) {
 	return func(s *Selector) {
@@ -8,12 +8,16 @@ package sqlgraph
 
 import (
 	"context"
+	stdsql "database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -559,6 +563,114 @@ func OrderByNeighborTerms(q *sql.Selector, s *Step, opts ...sql.OrderTerm) {
 	orderTerms(q, join, opts)
 }
 
+// JoinNeighbors left-joins the neighbors of the given step to the selector, and returns
+// their table. Joining the same step more than once returns the table of the first join.
+// For example, group users by the industry of their company.
+//
+// Note that joining O2M and M2M neighbors yields a row for each node and neighbor pair,
+// and therefore, multiplies the results of the aggregation functions (e.g. COUNT) that
+// are applied on the selector. Use AggregateNeighbors for aggregating these neighbors.
+func JoinNeighbors(q *sql.Selector, s *Step) *sql.SelectTable {
+	as := neighborsAlias(s)
+	if t, ok := q.JoinedTableView(as); ok {
+		if t, ok := t.(*sql.SelectTable); ok {
+			return t
+		}
+	}
+	build := sql.Dialect(q.Dialect())
+	toT := build.Table(s.To.Table).Schema(s.To.Schema).As(as)
+	switch {
+	case s.FromEdgeOwner():
+		q.LeftJoin(toT).
+			On(q.C(s.Edge.Columns[0]), toT.C(s.To.Column))
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		joinT := build.Table(s.Edge.Table).Schema(s.Edge.Schema).As(as + "_edge")
+		q.LeftJoin(joinT).
+			On(q.C(s.From.Column), joinT.C(pk2)).
+			LeftJoin(toT).
+			On(joinT.C(pk1), toT.C(s.To.Column))
+	case s.ToEdgeOwner():
+		q.LeftJoin(toT).
+			On(q.C(s.From.Column), toT.C(s.Edge.Columns[0]))
+	}
+	return toT
+}
+
+// AggregateNeighbors left-joins a sub-query that aggregates the neighbors of the given step
+// for each node, and returns the column that holds the aggregation result. The agg function
+// returns the aggregation expression on the neighbors table, and "as" names its column.
+// For example, sum the stars of the repositories of each user:
+//
+//	c := AggregateNeighbors(q, step, "sum_stars", func(t *sql.SelectTable) string {
+//		return sql.Sum(t.C("stars"))
+//	})
+//
+// Unlike JoinNeighbors, the sub-query yields at most one row for each node, and therefore,
+// O2M and M2M neighbors do not multiply the rows of the selector. Aggregations of the same
+// step share one sub-query.
+func AggregateNeighbors(q *sql.Selector, s *Step, as string, agg func(*sql.SelectTable) string) string {
+	var (
+		build = sql.Dialect(q.Dialect())
+		toT   = build.Table(s.To.Table).Schema(s.To.Schema)
+		name  = neighborsAlias(s) + "_agg"
+	)
+	if t, ok := q.JoinedTableView(name); ok {
+		if join, ok := t.(*sql.Selector); ok {
+			if expr := agg(toT); !slices.Contains(join.SelectedColumns(), expr) {
+				join.AppendSelectAs(expr, as)
+			}
+			return join.C(as)
+		}
+	}
+	var join *sql.Selector
+	switch {
+	case s.FromEdgeOwner():
+		join = build.Select(toT.C(s.To.Column)).
+			From(toT).
+			GroupBy(toT.C(s.To.Column)).
+			As(name)
+		q.LeftJoin(join).
+			On(q.C(s.Edge.Columns[0]), join.C(s.To.Column))
+	case s.ThroughEdgeTable():
+		pk1, pk2 := s.Edge.Columns[1], s.Edge.Columns[0]
+		if s.Edge.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		joinT := build.Table(s.Edge.Table).Schema(s.Edge.Schema).As(name + "_edge")
+		join = build.Select(joinT.C(pk2)).
+			From(toT).
+			Join(joinT).
+			On(toT.C(s.To.Column), joinT.C(pk1)).
+			GroupBy(joinT.C(pk2)).
+			As(name)
+		q.LeftJoin(join).
+			On(q.C(s.From.Column), join.C(pk2))
+	case s.ToEdgeOwner():
+		join = build.Select(toT.C(s.Edge.Columns[0])).
+			From(toT).
+			GroupBy(toT.C(s.Edge.Columns[0])).
+			As(name)
+		q.LeftJoin(join).
+			On(q.C(s.From.Column), join.C(s.Edge.Columns[0]))
+	}
+	join.AppendSelectAs(agg(toT), as)
+	return join.C(as)
+}
+
+// neighborsAlias returns the alias of the neighbors of the given step
+// when they are joined to a selector.
+func neighborsAlias(s *Step) string {
+	as := s.To.Table + "_" + strings.Join(s.Edge.Columns, "_")
+	if s.Edge.Inverse {
+		as += "_inverse"
+	}
+	return as
+}
+
 // NeighborsLimit provides a modifier function that limits the
 // number of neighbors (rows) loaded per parent row (node).
 type NeighborsLimit struct {
@@ -747,15 +859,23 @@ func (u *CreateSpec) SetField(column string, t field.Type, value driver.Value) {
 
 // CreateNode applies the CreateSpec on the graph. The operation creates a new
 // record in the database, and connects it to other nodes specified in spec.Edges.
+//
+// In case the OnConflict option is set and the record conflicts with an existing one, the
+// edges are added to the existing record. If its ID is not returned by the database, it
+// is queried using the values of the conflict columns (see sql.ConflictColumns).
 func CreateNode(ctx context.Context, drv dialect.Driver, spec *CreateSpec) error {
-	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect())}
+	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect()), upsert: len(spec.OnConflict) > 0}
 	cr := &creator{CreateSpec: spec, graph: gr}
 	return cr.node(ctx, drv)
 }
 
 // BatchCreate applies the BatchCreateSpec on the graph.
+//
+// In case the OnConflict option is set and the nodes have edges, the IDs of the
+// upserted nodes are queried using the values of the conflict columns before the
+// edges are added to them. Hence, the conflict columns must be set in this case.
 func BatchCreate(ctx context.Context, drv dialect.Driver, spec *BatchCreateSpec) error {
-	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect())}
+	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect()), upsert: len(spec.OnConflict) > 0}
 	cr := &batchCreator{BatchCreateSpec: spec, graph: gr}
 	return cr.nodes(ctx, drv)
 }
@@ -859,6 +979,17 @@ func UpdateNodes(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int
 	return cr.nodes(ctx, drv)
 }
 
+// UpdateNodesReturning applies the UpdateSpec on a set of nodes in the graph, and scans the
+// updated nodes using the ScanValues and Assign functions of the spec. On PostgreSQL and SQLite,
+// the nodes are returned by the UPDATE statement using the RETURNING clause. On other dialects,
+// or in case external tables are updated as well, the matched nodes are locked, updated and
+// selected in a transaction.
+func UpdateNodesReturning(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int, error) {
+	gr := graph{tx: drv, builder: sql.Dialect(drv.Dialect())}
+	cr := &updater{UpdateSpec: spec, graph: gr}
+	return cr.nodesReturning(ctx, drv)
+}
+
 // NotFoundError returns when trying to update an
 // entity, and it was not found in the database.
 type NotFoundError struct {
@@ -875,6 +1006,9 @@ func (e *NotFoundError) Error() string {
 type DeleteSpec struct {
 	Node      *NodeSpec
 	Predicate func(*sql.Selector)
+
+	ScanValues func(columns []string) ([]any, error)
+	Assign     func(columns []string, values []any) error
 }
 
 // NewDeleteSpec creates a new node deletion spec.
@@ -894,7 +1028,14 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
-	query, args := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector).Query()
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	del := builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).FromSelect(selector)
+	query, args := del.Query()
+	if err := del.Err(); err != nil {
+		return 0, err
+	}
 	if err := drv.Exec(ctx, query, args, &res); err != nil {
 		return 0, err
 	}
@@ -905,6 +1046,70 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	return int(affected), nil
 }
 
+// DeleteNodesReturning applies the DeleteSpec on the graph, and scans the deleted nodes
+// using the ScanValues and Assign functions of the spec. On PostgreSQL and SQLite, the nodes
+// are returned by the DELETE statement using the RETURNING clause. On other dialects, the
+// matched nodes are locked, selected and deleted in a transaction.
+func DeleteNodesReturning(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int, error) {
+	if spec.ScanValues == nil || spec.Assign == nil {
+		return 0, fmt.Errorf("sql/sqlgraph: missing scan functions for delete table %q", spec.Node.Table)
+	}
+	builder := sql.Dialect(drv.Dialect())
+	selector := builder.Select().
+		From(builder.Table(spec.Node.Table).Schema(spec.Node.Schema)).
+		WithContext(ctx)
+	if pred := spec.Predicate; pred != nil {
+		pred(selector)
+	}
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	if returningSupported(drv.Dialect()) {
+		rows := &sql.Rows{}
+		del := builder.Delete(spec.Node.Table).
+			Schema(spec.Node.Schema).
+			FromSelect(selector).
+			Returning(spec.Node.Columns...)
+		query, args := del.Query()
+		if err := del.Err(); err != nil {
+			return 0, err
+		}
+		if err := drv.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		return scanNodes(rows, spec.ScanValues, spec.Assign)
+	}
+	if spec.Node.ID == nil {
+		return 0, fmt.Errorf("sql/sqlgraph: missing node id for delete table %q", spec.Node.Table)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := func() (int, error) {
+		ids, err := lockIDs(ctx, tx, selector.Select(spec.Node.ID.Column))
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		affected, err := selectNodes(ctx, tx, builder, spec.Node, ids, spec.ScanValues, spec.Assign)
+		if err != nil {
+			return 0, err
+		}
+		var (
+			res         sql.Result
+			query, args = builder.Delete(spec.Node.Table).Schema(spec.Node.Schema).Where(matchID(spec.Node.ID.Column, ids)).Query()
+		)
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return 0, err
+		}
+		return affected, nil
+	}()
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return affected, tx.Commit()
+}
+
 // QuerySpec holds the information for querying
 // nodes in the graph.
 type QuerySpec struct {
@@ -947,6 +1152,60 @@ func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int,
 	return qr.count(ctx, drv)
 }
 
+// ScanGroupBy groups the rows of the given selector by the given columns, applies the
+// aggregation functions on them, and scans the result to v. If no columns were selected,
+// the selection is the group-by columns followed by the aggregation functions.
+func ScanGroupBy[F ~func(*sql.Selector) string](ctx context.Context, drv dialect.Driver, selector *sql.Selector, columns []string, fns []F, v any) error {
+	aggregation := make([]string, 0, len(fns))
+	for _, fn := range fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		selection := make([]string, 0, len(columns)+len(fns))
+		for _, c := range columns {
+			selection = append(selection, selector.C(c))
+		}
+		selection = append(selection, aggregation...)
+		selector.Select(selection...)
+	}
+	selector.GroupBy(selector.Columns(columns...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	return scanSelector(ctx, drv, selector, v)
+}
+
+// ScanSelect applies the aggregation functions on the given selector, and scans the result
+// to v. The aggregations replace the selection if no columns were selected, and are appended
+// to it otherwise.
+func ScanSelect[F ~func(*sql.Selector) string](ctx context.Context, drv dialect.Driver, selector *sql.Selector, columns []string, fns []F, v any) error {
+	aggregation := make([]string, 0, len(fns))
+	for _, fn := range fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(columns); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	return scanSelector(ctx, drv, selector, v)
+}
+
+// scanSelector executes the given selector and scans its rows to v.
+func scanSelector(ctx context.Context, drv dialect.Driver, selector *sql.Selector, v any) error {
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
 // EdgeQuerySpec holds the information for querying
 // edges in the graph.
 type EdgeQuerySpec struct {
@@ -989,6 +1248,237 @@ func QueryEdges(ctx context.Context, drv dialect.Driver, spec *EdgeQuerySpec) er
 	return rows.Err()
 }
 
+// RefreshSpec holds the information for refreshing a materialized view.
+type RefreshSpec struct {
+	Table   string
+	Schema  string
+	Columns []string
+	// ViewAs and ViewFor hold the default definition of the view and its definitions per dialect.
+	// They are used for repopulating the table that emulates the view on dialects that do not
+	// support materialized views.
+	ViewAs  string
+	ViewFor map[string]string
+	// Concurrently refreshes the view without locking out concurrent selects (PostgreSQL only).
+	Concurrently bool
+}
+
+// RefreshView refreshes the data of a materialized view. On PostgreSQL, it executes the REFRESH
+// MATERIALIZED VIEW statement. On other dialects, the table that emulates the view is cleared and
+// repopulated from the view definition within a transaction.
+func RefreshView(ctx context.Context, drv dialect.Driver, spec *RefreshSpec) error {
+	b := &sql.Builder{}
+	b.SetDialect(drv.Dialect())
+	ident := func(b *sql.Builder) {
+		if spec.Schema != "" {
+			b.Ident(spec.Schema).WriteString(".")
+		}
+		b.Ident(spec.Table)
+	}
+	if drv.Dialect() == dialect.Postgres {
+		b.WriteString("REFRESH MATERIALIZED VIEW ")
+		if spec.Concurrently {
+			b.WriteString("CONCURRENTLY ")
+		}
+		ident(b)
+		query, args := b.Query()
+		return drv.Exec(ctx, query, args, nil)
+	}
+	def := spec.ViewFor[drv.Dialect()]
+	if def == "" {
+		def = spec.ViewAs
+	}
+	if def == "" {
+		return fmt.Errorf("sqlgraph: missing definition for refreshing view %q", spec.Table)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	query, args := sql.Dialect(drv.Dialect()).Delete(spec.Table).Schema(spec.Schema).Query()
+	if err := tx.Exec(ctx, query, args, nil); err != nil {
+		return rollback(tx, err)
+	}
+	b.WriteString("INSERT INTO ")
+	ident(b)
+	b.Pad().Wrap(func(b *sql.Builder) { b.IdentComma(spec.Columns...) }).
+		WriteString(" SELECT ").IdentComma(spec.Columns...).
+		WriteString(" FROM (").WriteString(def).WriteString(") AS ").Ident("v")
+	query, args = b.Query()
+	if err := tx.Exec(ctx, query, args, nil); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// ClaimSpec holds the information for claiming nodes in the graph. That is, atomically selecting
+// a limited set of nodes that are not locked by other transactions and updating them. For example,
+// for taking jobs from a work-queue.
+type ClaimSpec struct {
+	Node      *NodeSpec
+	From      *sql.Selector // Query for selecting the nodes to claim.
+	Limit     int
+	Fields    []*FieldSpec // Fields to set on the claimed nodes.
+	Lease     *LeaseSpec   // Lease of the claimed nodes, if any.
+	Modifiers []func(*sql.UpdateBuilder)
+
+	ScanValues func(columns []string) ([]any, error)
+	Assign     func(columns []string, values []any) error
+}
+
+// NewClaimSpec creates a new node claim spec.
+func NewClaimSpec(table string, columns []string, id *FieldSpec) *ClaimSpec {
+	return &ClaimSpec{
+		Node: &NodeSpec{
+			ID:      id,
+			Table:   table,
+			Columns: columns,
+		},
+	}
+}
+
+// SetField appends a new field setter to the claim spec.
+func (c *ClaimSpec) SetField(column string, t field.Type, value driver.Value) {
+	c.Fields = append(c.Fields, &FieldSpec{
+		Column: column,
+		Type:   t,
+		Value:  value,
+	})
+}
+
+// SetLease sets the lease column of the claimed nodes, and for how long they are leased.
+func (c *ClaimSpec) SetLease(column string, d time.Duration) {
+	c.Lease = &LeaseSpec{Column: column, Duration: d}
+}
+
+// LeaseSpec describes the lease of claimed nodes. Nodes whose lease column holds an
+// unexpired deadline are not claimed, and the lease column of the claimed nodes is
+// set to the current time plus the lease duration.
+type LeaseSpec struct {
+	Column   string
+	Duration time.Duration
+}
+
+// clock returns the expressions for the current time and the lease deadline. The clock of the
+// database is used in order to not depend on the clocks of the clients being in sync, except for
+// SQLite that runs in the process of its client, and stores the time values in the text format of
+// its driver, which cannot be compared with CURRENT_TIMESTAMP. Note that in MySQL, the lease column
+// is compared with the time in the session time zone, which is expected to be the time zone of the
+// driver (see the loc option of the MySQL driver).
+func (l *LeaseSpec) clock(d string) (now, deadline sql.Querier) {
+	switch d {
+	case dialect.Postgres:
+		return sql.Expr("CURRENT_TIMESTAMP"), sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CURRENT_TIMESTAMP + ").Arg(l.Duration.Microseconds()).WriteString(" * INTERVAL '1 microsecond'")
+		})
+	case dialect.MySQL:
+		return sql.Expr("CURRENT_TIMESTAMP(6)"), sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CURRENT_TIMESTAMP(6) + INTERVAL ").Arg(l.Duration.Microseconds()).WriteString(" MICROSECOND")
+		})
+	default:
+		t := time.Now()
+		return sql.ExprFunc(func(b *sql.Builder) { b.Arg(t) }), sql.ExprFunc(func(b *sql.Builder) { b.Arg(t.Add(l.Duration)) })
+	}
+}
+
+// AddModifier adds a new statement modifier to the spec.
+func (c *ClaimSpec) AddModifier(m func(*sql.UpdateBuilder)) {
+	c.Modifiers = append(c.Modifiers, m)
+}
+
+// ClaimNodes claims up to Limit nodes that are matched by the From selector, sets their fields and
+// scans them using the ScanValues and Assign functions of the spec. On PostgreSQL, the nodes are
+// selected using FOR UPDATE SKIP LOCKED and updated by a single UPDATE ... RETURNING statement. On
+// SQLite, which does not support row-level locking but serializes writes, a single UPDATE ... RETURNING
+// statement is used as well. On other dialects, the nodes are selected using FOR UPDATE SKIP LOCKED,
+// updated and queried within a transaction.
+func ClaimNodes(ctx context.Context, drv dialect.Driver, spec *ClaimSpec) (int, error) {
+	switch {
+	case spec.Node.ID == nil:
+		return 0, fmt.Errorf("sql/sqlgraph: missing node id for claim table %q", spec.Node.Table)
+	case spec.From == nil:
+		return 0, fmt.Errorf("sql/sqlgraph: missing selector for claim table %q", spec.Node.Table)
+	case spec.ScanValues == nil || spec.Assign == nil:
+		return 0, fmt.Errorf("sql/sqlgraph: missing scan functions for claim table %q", spec.Node.Table)
+	case spec.Limit <= 0:
+		return 0, fmt.Errorf("sql/sqlgraph: invalid limit %d for claim table %q", spec.Limit, spec.Node.Table)
+	}
+	var (
+		builder  = sql.Dialect(drv.Dialect())
+		update   = builder.Update(spec.Node.Table).Schema(spec.Node.Schema)
+		selector = spec.From.Select(spec.From.C(spec.Node.ID.Column)).Limit(spec.Limit)
+	)
+	for _, f := range spec.Fields {
+		update.Set(f.Column, f.Value)
+	}
+	if l := spec.Lease; l != nil {
+		now, deadline := l.clock(drv.Dialect())
+		selector.Where(sql.Or(
+			sql.IsNull(selector.C(l.Column)),
+			sql.P(func(b *sql.Builder) {
+				b.Ident(selector.C(l.Column)).WriteOp(sql.OpLTE).Join(now)
+			}),
+		))
+		update.Set(l.Column, deadline)
+	}
+	for _, m := range spec.Modifiers {
+		m(update)
+	}
+	if drv.Dialect() != dialect.SQLite {
+		selector.ForUpdate(sql.WithLockAction(sql.SkipLocked), sql.WithLockTables(spec.Node.Table))
+	}
+	if returningSupported(drv.Dialect()) {
+		update.Where(sql.In(spec.Node.ID.Column, selector))
+		if err := update.Err(); err != nil {
+			return 0, err
+		}
+		rows := &sql.Rows{}
+		query, args := update.Returning(spec.Node.Columns...).Query()
+		if err := drv.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		return scanNodes(rows, spec.ScanValues, spec.Assign)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	claimed, err := func() (int, error) {
+		if err := selector.Err(); err != nil {
+			return 0, err
+		}
+		var (
+			ids         []driver.Value
+			rows        = &sql.Rows{}
+			query, args = selector.Query()
+		)
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return 0, fmt.Errorf("querying node ids: %w", err)
+		}
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan node ids: %w", err)
+		}
+		if err := rows.Close(); err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		update.Where(matchID(spec.Node.ID.Column, ids))
+		if err := update.Err(); err != nil {
+			return 0, err
+		}
+		if !update.Empty() {
+			query, args := update.Query()
+			if err := tx.Exec(ctx, query, args, nil); err != nil {
+				return 0, err
+			}
+		}
+		return selectNodes(ctx, tx, builder, spec.Node, ids, spec.ScanValues, spec.Assign)
+	}()
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return claimed, tx.Commit()
+}
+
 type query struct {
 	graph
 	*QuerySpec
@@ -1001,6 +1491,9 @@ func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
 		return err
 	}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -1056,6 +1549,9 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 		selector.Count(columns...)
 	}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return 0, err
 	}
@@ -1264,6 +1760,71 @@ func (u *updater) nodes(ctx context.Context, drv dialect.Driver) (int, error) {
 	return affected, tx.Commit()
 }
 
+func (u *updater) nodesReturning(ctx context.Context, drv dialect.Driver) (int, error) {
+	if u.Node.ID == nil {
+		return 0, fmt.Errorf("sql/sqlgraph: missing node id for update table %q", u.Node.Table)
+	}
+	if u.ScanValues == nil || u.Assign == nil {
+		return 0, fmt.Errorf("sql/sqlgraph: missing scan functions for update table %q", u.Node.Table)
+	}
+	var (
+		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
+		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
+		update     = u.builder.Update(u.Node.Table).Schema(u.Node.Schema)
+		selector   = u.builder.Select(u.Node.ID.Column).
+				From(u.builder.Table(u.Node.Table).Schema(u.Node.Schema)).
+				WithContext(ctx)
+	)
+	if err := u.setTableColumns(update, addEdges, clearEdges); err != nil {
+		return 0, err
+	}
+	if pred := u.Predicate; pred != nil {
+		pred(selector)
+	}
+	// In case of single statement update, the nodes are returned by the UPDATE statement.
+	if returningSupported(drv.Dialect()) && !hasExternalEdges(addEdges, clearEdges) {
+		update.FromSelect(selector)
+		for _, m := range u.Modifiers {
+			m(update)
+		}
+		if err := update.Err(); err != nil {
+			return 0, err
+		}
+		if update.Empty() {
+			return 0, nil
+		}
+		rows := &sql.Rows{}
+		query, args := update.Returning(u.Node.Columns...).Query()
+		if err := drv.Query(ctx, query, args, rows); err != nil {
+			return 0, err
+		}
+		return scanNodes(rows, u.ScanValues, u.Assign)
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	u.tx = tx
+	affected, err := func() (int, error) {
+		ids, err := lockIDs(ctx, tx, selector)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		update.Where(matchID(u.Node.ID.Column, ids))
+		if _, err := u.updateTable(ctx, update); err != nil {
+			return 0, err
+		}
+		if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
+			return 0, err
+		}
+		return selectNodes(ctx, tx, u.builder, u.Node, ids, u.ScanValues, u.Assign)
+	}()
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return affected, tx.Commit()
+}
+
 func (u *updater) updateTable(ctx context.Context, stmt *sql.UpdateBuilder) (int, error) {
 	for _, m := range u.Modifiers {
 		m(stmt)
@@ -1401,8 +1962,9 @@ type creator struct {
 
 func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
 	var (
-		edges  = EdgeSpecs(c.Edges).GroupRel()
-		insert = c.builder.Insert(c.Table).Schema(c.Schema).Default()
+		edges    = EdgeSpecs(c.Edges).GroupRel()
+		insert   = c.builder.Insert(c.Table).Schema(c.Schema).Default()
+		provided = c.ID != nil && c.ID.Value != nil
 	)
 	if err := c.setTableColumns(insert, edges); err != nil {
 		return err
@@ -1425,6 +1987,14 @@ func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
 		if err := c.insert(ctx, insert); err != nil {
 			return err
 		}
+		// In MySQL, the LAST_INSERT_ID cannot be used for getting the ID of a conflicting record
+		// in case it was provided by the user or it is not numeric. Hence, it is queried using
+		// the conflict columns before adding the edges, and as in BatchCreate, they must be set.
+		if (provided || !c.ID.Type.Numeric()) && len(c.OnConflict) > 0 && insert.Dialect() == dialect.MySQL && hasExternalEdges(edges, nil) {
+			if err := c.conflictID(ctx, insert.ConflictColumns()); err != nil {
+				return err
+			}
+		}
 		if err := c.graph.addM2MEdges(ctx, []driver.Value{c.ID.Value}, edges[M2M]); err != nil {
 			return err
 		}
@@ -1472,7 +2042,30 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 			return c.tx.Exec(ctx, query, args, nil)
 		}
 	}
-	return c.insertLastID(ctx, insert.Returning(c.ID.Column))
+	err := c.insertLastID(ctx, insert.Returning(c.ID.Column))
+	// In case of "DO NOTHING", or an update that was skipped by its WHERE clause, the conflicting
+	// record is not returned by the database. If edges should be added to it, its ID is queried
+	// instead. Otherwise, sql.ErrNoRows is returned to indicate that no record was affected.
+	if columns := insert.ConflictColumns(); errors.Is(err, stdsql.ErrNoRows) && len(columns) > 0 && hasExternalEdges(EdgeSpecs(c.Edges).GroupRel(), nil) {
+		return c.conflictID(ctx, columns)
+	}
+	return err
+}
+
+// conflictID queries the ID of the record that conflicted with the
+// inserted node using the values it holds in the conflict columns.
+func (c *creator) conflictID(ctx context.Context, columns []string) error {
+	values := make(map[string]driver.Value, len(c.Fields)+1)
+	if c.ID.Value != nil {
+		values[c.ID.Column] = c.ID.Value
+	}
+	err := setTableColumns(c.Fields, EdgeSpecs(c.Edges).GroupRel(), func(column string, value driver.Value) {
+		values[column] = value
+	})
+	if err != nil {
+		return err
+	}
+	return queryConflictIDs(ctx, c.tx, c.builder, []*CreateSpec{c.CreateSpec}, columns, []map[string]driver.Value{values})
 }
 
 // ensureConflict ensures the ON CONFLICT is added to the insert statement.
@@ -1567,6 +2160,9 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 		if err := c.batchInsert(ctx, tx, insert); err != nil {
 			return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
 		}
+		if err := c.conflictIDs(ctx, tx, insert, values); err != nil {
+			return err
+		}
 		if err := c.batchAddM2M(ctx, c.BatchCreateSpec); err != nil {
 			return err
 		}
@@ -1587,14 +2183,32 @@ func (c *batchCreator) nodes(ctx context.Context, drv dialect.Driver) error {
 
 // mayTx opens a new transaction if the create operation spans across multiple statements.
 func (c *batchCreator) mayTx(ctx context.Context, drv dialect.Driver) (dialect.Tx, error) {
+	if c.hasExternalEdges() {
+		return drv.Tx(ctx)
+	}
+	return dialect.NopTx(drv), nil
+}
+
+// hasExternalEdges reports if one of the nodes has edges that reside in external tables.
+func (c *batchCreator) hasExternalEdges() bool {
 	for _, node := range c.Nodes {
 		for _, edge := range node.Edges {
 			if isExternalEdge(edge) {
-				return drv.Tx(ctx)
+				return true
 			}
 		}
 	}
-	return dialect.NopTx(drv), nil
+	return false
+}
+
+// conflictIDs queries the IDs of the upserted nodes that have edges in external tables using
+// the values they hold in the conflict columns, because the IDs returned by the database (or
+// computed from LAST_INSERT_ID) cannot be matched to the nodes in case of conflicts.
+func (c *batchCreator) conflictIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, values []map[string]driver.Value) error {
+	if len(c.OnConflict) == 0 || !c.hasExternalEdges() {
+		return nil
+	}
+	return queryConflictIDs(ctx, tx, c.builder, c.Nodes, insert.ConflictColumns(), values)
 }
 
 // batchInsert inserts a batch of nodes to their table and sets their ID if it was not provided by the user.
@@ -1649,6 +2263,9 @@ func (es EdgeSpecs) FilterRel(r Rel) EdgeSpecs {
 type graph struct {
 	tx      dialect.ExecQuerier
 	builder *sql.DialectBuilder
+	// upsert indicates the nodes were upserted, and therefore,
+	// they may already be connected to some of their edges.
+	upsert bool
 }
 
 func (g *graph) clearM2MEdges(ctx context.Context, ids []driver.Value, edges EdgeSpecs) error {
@@ -1844,12 +2461,37 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 		// Setting the FK value of the "other" table without clearing it before, is not allowed.
 		// Including no-op (same id), because we rely on "affected" to determine if the FK set.
 		if ids := edge.Target.Nodes; int(affected) < len(ids) {
+			// Upserted nodes may already be connected to
+			// some of the nodes, and it is considered a no-op.
+			if g.upsert {
+				linked, err := g.countFKEdges(ctx, id, edge, p)
+				if err != nil {
+					return err
+				}
+				if linked == len(ids) {
+					continue
+				}
+			}
 			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0])}
 		}
 	}
 	return nil
 }
 
+// countFKEdges counts the nodes of the given edge that are connected to the node with the given id.
+func (g *graph) countFKEdges(ctx context.Context, id driver.Value, edge *EdgeSpec, p *sql.Predicate) (int, error) {
+	rows := &sql.Rows{}
+	query, args := g.builder.Select(sql.Count("*")).
+		From(g.builder.Table(edge.Table).Schema(edge.Schema)).
+		Where(sql.And(p, sql.EQ(edge.Columns[0], id))).
+		Query()
+	if err := g.tx.Query(ctx, query, args, rows); err != nil {
+		return 0, fmt.Errorf("count %s edges for table %s: %w", edge.Rel, edge.Table, err)
+	}
+	defer rows.Close()
+	return sql.ScanInt(rows)
+}
+
 func hasExternalEdges(addEdges, clearEdges map[Rel][]*EdgeSpec) bool {
 	// M2M edges reside in a join-table, and O2M edges reside
 	// in the M2O table (the entity that holds the FK).
@@ -1912,23 +2554,13 @@ func (c *creator) insertLastID(ctx context.Context, insert *sql.InsertBuilder) e
 			return err
 		}
 		defer rows.Close()
-		switch _, ok := c.ID.Value.(field.ValueScanner); {
-		case ok:
-			// If the ID implements the sql.Scanner
-			// interface it should be a pointer type.
-			return sql.ScanOne(rows, c.ID.Value)
-		case c.ID.Type.Numeric():
-			// Normalize the type to int64 to make it
-			// looks like LastInsertId.
-			id, err := sql.ScanInt64(rows)
-			if err != nil {
+		if !rows.Next() {
+			if err := rows.Err(); err != nil {
 				return err
 			}
-			c.ID.Value = id
-			return nil
-		default:
-			return sql.ScanOne(rows, &c.ID.Value)
+			return stdsql.ErrNoRows
 		}
+		return scanID(rows, c.ID)
 	}
 	// MySQL.
 	var res sql.Result
@@ -1961,26 +2593,8 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 		}
 		defer rows.Close()
 		for i := 0; rows.Next(); i++ {
-			node := c.Nodes[i]
-			switch _, ok := node.ID.Value.(field.ValueScanner); {
-			case ok:
-				// If the ID implements the sql.Scanner
-				// interface it should be a pointer type.
-				if err := rows.Scan(node.ID.Value); err != nil {
-					return err
-				}
-			case node.ID.Type.Numeric():
-				// Normalize the type to int64 to make it looks
-				// like LastInsertId.
-				var id int64
-				if err := rows.Scan(&id); err != nil {
-					return err
-				}
-				node.ID.Value = id
-			default:
-				if err := rows.Scan(&node.ID.Value); err != nil {
-					return err
-				}
+			if err := scanID(rows, c.Nodes[i].ID); err != nil {
+				return err
 			}
 		}
 		return rows.Err()
@@ -2010,6 +2624,218 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 	return nil
 }
 
+// scanID scans the ID of a node, and the given values, from the current row.
+func scanID(rows *sql.Rows, id *FieldSpec, dest ...any) error {
+	switch _, ok := id.Value.(field.ValueScanner); {
+	case ok:
+		// If the ID implements the sql.Scanner
+		// interface it should be a pointer type.
+		return rows.Scan(append([]any{id.Value}, dest...)...)
+	case id.Type.Numeric():
+		// Normalize the type to int64 to make it
+		// looks like LastInsertId.
+		var v int64
+		if err := rows.Scan(append([]any{&v}, dest...)...); err != nil {
+			return err
+		}
+		id.Value = v
+		return nil
+	default:
+		return rows.Scan(append([]any{&id.Value}, dest...)...)
+	}
+}
+
+// queryConflictIDs queries the IDs of the records that conflicted with the given nodes on
+// insertion using the values of the conflict columns, and sets them on the nodes. The IDs
+// are queried using a single statement, and matched to the nodes by their conflict values.
+func queryConflictIDs(ctx context.Context, tx dialect.ExecQuerier, b *sql.DialectBuilder, nodes []*CreateSpec, columns []string, values []map[string]driver.Value) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("sql/sqlgraph: missing conflict columns for adding edges to upserted nodes of table %q", nodes[0].Table)
+	}
+	var (
+		in    []any
+		preds = make([]*sql.Predicate, 0, len(nodes))
+		byKey = make(map[string][]*CreateSpec, len(nodes))
+	)
+	for i, node := range nodes {
+		vs := make([]any, len(columns))
+		and := make([]*sql.Predicate, len(columns))
+		for j, c := range columns {
+			v, ok := values[i][c]
+			if !ok || v == nil {
+				return fmt.Errorf("sql/sqlgraph: missing value of conflict column %q for table %q", c, node.Table)
+			}
+			vs[j], and[j] = v, sql.EQ(c, v)
+		}
+		key, err := conflictKey(vs)
+		if err != nil {
+			return err
+		}
+		if _, ok := byKey[key]; !ok {
+			in, preds = append(in, vs[0]), append(preds, sql.And(and...))
+		}
+		byKey[key] = append(byKey[key], node)
+	}
+	// Use a simple IN predicate for a single conflict column.
+	where := sql.Or(preds...)
+	if len(columns) == 1 {
+		where = sql.In(columns[0], in...)
+	}
+	rows := &sql.Rows{}
+	query, args := b.Select(append([]string{nodes[0].ID.Column}, columns...)...).
+		From(b.Table(nodes[0].Table).Schema(nodes[0].Schema)).
+		Where(where).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("query conflicting node ids: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			id   = &FieldSpec{Column: nodes[0].ID.Column, Type: nodes[0].ID.Type}
+			vs   = make([]any, len(columns))
+			dest = make([]any, len(columns))
+		)
+		for i := range vs {
+			dest[i] = &vs[i]
+		}
+		if err := scanID(rows, id, dest...); err != nil {
+			return err
+		}
+		key, err := conflictKey(vs)
+		if err != nil {
+			return err
+		}
+		for _, node := range byKey[key] {
+			if err := setConflictID(node.ID, id.Value); err != nil {
+				return err
+			}
+		}
+		delete(byKey, key)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(byKey) > 0 {
+		return fmt.Errorf("sql/sqlgraph: query conflicting node ids of table %q: %w", nodes[0].Table, stdsql.ErrNoRows)
+	}
+	return nil
+}
+
+// setConflictID sets the given ID value that was queried from the database on the given field.
+func setConflictID(id *FieldSpec, v any) error {
+	if s, ok := id.Value.(field.ValueScanner); ok {
+		return s.Scan(v)
+	}
+	// Some drivers (e.g. MySQL) return textual values as bytes.
+	if b, ok := v.([]byte); ok && id.Type == field.TypeString {
+		v = string(b)
+	}
+	id.Value = v
+	return nil
+}
+
+// conflictKey returns a key for the given conflict values, that allows matching the values
+// of the nodes with the ones that were scanned from the database, regardless of their Go types.
+func conflictKey(vs []any) (string, error) {
+	var b strings.Builder
+	for i, v := range vs {
+		if vr, ok := v.(driver.Valuer); ok {
+			var err error
+			if v, err = vr.Value(); err != nil {
+				return "", err
+			}
+		}
+		switch x := v.(type) {
+		case []byte:
+			v = string(x)
+		case time.Time:
+			v = x.UTC().Format(time.RFC3339Nano)
+		case bool:
+			v = 0
+			if x {
+				v = 1
+			}
+		}
+		if i > 0 {
+			b.WriteByte(0)
+		}
+		fmt.Fprint(&b, v)
+	}
+	return b.String(), nil
+}
+
+// returningSupported reports if the given dialect supports the
+// RETURNING clause in UPDATE and DELETE statements.
+func returningSupported(d string) bool {
+	return d == dialect.Postgres || d == dialect.SQLite
+}
+
+// lockIDs queries the IDs of the nodes matched by the given selector,
+// and locks their rows in dialects that support row-level locking.
+func lockIDs(ctx context.Context, tx dialect.ExecQuerier, selector *sql.Selector) ([]driver.Value, error) {
+	if selector.Dialect() != dialect.SQLite {
+		selector.ForUpdate()
+	}
+	var (
+		ids         []driver.Value
+		rows        = &sql.Rows{}
+		query, args = selector.Query()
+	)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("querying node ids: %w", err)
+	}
+	defer rows.Close()
+	if err := sql.ScanSlice(rows, &ids); err != nil {
+		return nil, fmt.Errorf("scan node ids: %w", err)
+	}
+	return ids, rows.Close()
+}
+
+// selectNodes queries the nodes with the given IDs and scans them using the given functions.
+func selectNodes(ctx context.Context, tx dialect.ExecQuerier, b *sql.DialectBuilder, node *NodeSpec, ids []driver.Value, scan func([]string) ([]any, error), assign func([]string, []any) error) (int, error) {
+	rows := &sql.Rows{}
+	query, args := b.Select(node.Columns...).
+		From(b.Table(node.Table).Schema(node.Schema)).
+		Where(matchID(node.ID.Column, ids)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	return scanNodes(rows, scan, assign)
+}
+
+// scanNodes scans all rows using the given functions and returns their count.
+func scanNodes(rows *sql.Rows, scan func([]string) ([]any, error), assign func([]string, []any) error) (int, error) {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for ; rows.Next(); n++ {
+		values, err := scan(columns)
+		if err != nil {
+			return 0, err
+		}
+		for i, v := range values {
+			if _, ok := v.(*sql.UnknownType); ok {
+				values[i] = sql.ScanTypeOf(rows, i)
+			}
+		}
+		if err := rows.Scan(values...); err != nil {
+			return 0, fmt.Errorf("failed scanning rows: %w", err)
+		}
+		if err := assign(columns, values); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
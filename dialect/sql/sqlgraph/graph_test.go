@@ -6,18 +6,22 @@ package sqlgraph
 
 import (
 	"context"
+	stdsql "database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"net/netip"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1094,6 +1098,164 @@ func TestOrderByNeighborTerms(t *testing.T) {
 	})
 }
 
+func TestJoinNeighbors(t *testing.T) {
+	build := sql.Dialect(dialect.Postgres)
+	t1 := build.Table("users")
+	s := build.Select().
+		From(t1)
+	t.Run("M2O", func(t *testing.T) {
+		s := s.Clone()
+		step := NewStep(
+			From("users", "id"),
+			To("workplace", "id"),
+			Edge(M2O, true, "users", "workplace_id"),
+		)
+		w := JoinNeighbors(s, step)
+		require.Equal(t, w, JoinNeighbors(s, step), "join should be reused")
+		s.Select(t1.C("name"), w.C("name")).GroupBy(t1.C("name"), w.C("name"))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "users"."name", "workplace_workplace_id_inverse"."name" FROM "users" LEFT JOIN "workplace" AS "workplace_workplace_id_inverse" ON "users"."workplace_id" = "workplace_workplace_id_inverse"."id" GROUP BY "users"."name", "workplace_workplace_id_inverse"."name"`, query)
+	})
+	t.Run("O2M", func(t *testing.T) {
+		s := s.Clone()
+		r := JoinNeighbors(s, NewStep(
+			From("users", "id"),
+			To("repos", "id"),
+			Edge(O2M, false, "repos", "owner_id"),
+		))
+		s.Select(t1.C("id"), sql.Sum(r.C("num_stars"))).GroupBy(t1.C("id"))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "users"."id", SUM("repos_owner_id"."num_stars") FROM "users" LEFT JOIN "repos" AS "repos_owner_id" ON "users"."id" = "repos_owner_id"."owner_id" GROUP BY "users"."id"`, query)
+	})
+	t.Run("M2M", func(t *testing.T) {
+		s := s.Clone()
+		g := JoinNeighbors(s, NewStep(
+			From("users", "id"),
+			To("groups", "id"),
+			Edge(M2M, false, "user_groups", "user_id", "group_id"),
+		))
+		s.Select(g.C("name"), sql.Count("*")).GroupBy(g.C("name"))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "groups_user_id_group_id"."name", COUNT(*) FROM "users" LEFT JOIN "user_groups" AS "groups_user_id_group_id_edge" ON "users"."id" = "groups_user_id_group_id_edge"."user_id" LEFT JOIN "groups" AS "groups_user_id_group_id" ON "groups_user_id_group_id_edge"."group_id" = "groups_user_id_group_id"."id" GROUP BY "groups_user_id_group_id"."name"`, query)
+	})
+}
+
+func TestAggregateNeighbors(t *testing.T) {
+	build := sql.Dialect(dialect.Postgres)
+	t1 := build.Table("users")
+	s := build.Select().
+		From(t1)
+	t.Run("M2O", func(t *testing.T) {
+		s := s.Clone()
+		c := AggregateNeighbors(s, NewStep(
+			From("users", "id"),
+			To("workplace", "id"),
+			Edge(M2O, true, "users", "workplace_id"),
+		), "max_size", func(t *sql.SelectTable) string {
+			return sql.Max(t.C("size"))
+		})
+		s.Select(t1.C("name"), sql.Max(c)).GroupBy(t1.C("name"))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "users"."name", MAX("workplace_workplace_id_inverse_agg"."max_size") FROM "users" LEFT JOIN (SELECT "workplace"."id", MAX("workplace"."size") AS "max_size" FROM "workplace" GROUP BY "workplace"."id") AS "workplace_workplace_id_inverse_agg" ON "users"."workplace_id" = "workplace_workplace_id_inverse_agg"."id" GROUP BY "users"."name"`, query)
+	})
+	t.Run("O2M", func(t *testing.T) {
+		s := s.Clone()
+		step := NewStep(
+			From("users", "id"),
+			To("repos", "id"),
+			Edge(O2M, false, "repos", "owner_id"),
+		)
+		sum := func(t *sql.SelectTable) string { return sql.Sum(t.C("num_stars")) }
+		c1 := AggregateNeighbors(s, step, "sum_num_stars", sum)
+		require.Equal(t, c1, AggregateNeighbors(s, step, "sum_num_stars", sum), "aggregation should be reused")
+		c2 := AggregateNeighbors(s, step, "count_num_stars", func(t *sql.SelectTable) string {
+			return sql.Count(t.C("num_stars"))
+		})
+		s.Select(t1.C("name"), sql.Count("*"), sql.Sum(c1), sql.Sum(c2)).GroupBy(t1.C("name"))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT "users"."name", COUNT(*), SUM("repos_owner_id_agg"."sum_num_stars"), SUM("repos_owner_id_agg"."count_num_stars") FROM "users" LEFT JOIN (SELECT "repos"."owner_id", SUM("repos"."num_stars") AS "sum_num_stars", COUNT("repos"."num_stars") AS "count_num_stars" FROM "repos" GROUP BY "repos"."owner_id") AS "repos_owner_id_agg" ON "users"."id" = "repos_owner_id_agg"."owner_id" GROUP BY "users"."name"`, query)
+	})
+	t.Run("M2M", func(t *testing.T) {
+		s := s.Clone()
+		c := AggregateNeighbors(s, NewStep(
+			From("users", "id"),
+			To("groups", "id"),
+			Edge(M2M, false, "user_groups", "user_id", "group_id"),
+		), "min_name", func(t *sql.SelectTable) string {
+			return sql.Min(t.C("name"))
+		})
+		s.Select(sql.Min(c))
+		query, args := s.Query()
+		require.Empty(t, args)
+		require.Equal(t, `SELECT MIN("groups_user_id_group_id_agg"."min_name") FROM "users" LEFT JOIN (SELECT "groups_user_id_group_id_agg_edge"."user_id", MIN("groups"."name") AS "min_name" FROM "groups" JOIN "user_groups" AS "groups_user_id_group_id_agg_edge" ON "groups"."id" = "groups_user_id_group_id_agg_edge"."group_id" GROUP BY "groups_user_id_group_id_agg_edge"."user_id") AS "groups_user_id_group_id_agg" ON "users"."id" = "groups_user_id_group_id_agg"."user_id"`, query)
+	})
+	t.Run("Neighbors", func(t *testing.T) {
+		ctx := context.Background()
+		drv, err := sql.Open(dialect.SQLite, "file:neighbors?mode=memory")
+		require.NoError(t, err)
+		defer drv.Close()
+		for _, stmt := range []string{
+			"CREATE TABLE users (id INTEGER PRIMARY KEY, country TEXT)",
+			"CREATE TABLE repos (id INTEGER PRIMARY KEY, num_stars INTEGER, owner_id INTEGER)",
+			"CREATE TABLE user_groups (user_id INTEGER, group_id INTEGER)",
+			"CREATE TABLE groups (id INTEGER PRIMARY KEY, size INTEGER)",
+			"INSERT INTO users (id, country) VALUES (1, 'dk'), (2, 'dk'), (3, 'il')",
+			"INSERT INTO repos (id, num_stars, owner_id) VALUES (1, 10, 1), (2, 20, 1), (3, 30, 1), (4, 5, 2)",
+			"INSERT INTO groups (id, size) VALUES (1, 100), (2, 200)",
+			"INSERT INTO user_groups (user_id, group_id) VALUES (1, 1), (1, 2), (3, 1)",
+		} {
+			_, err := drv.DB().ExecContext(ctx, stmt)
+			require.NoError(t, err)
+		}
+		build := sql.Dialect(dialect.SQLite)
+		t1 := build.Table("users")
+		s := build.Select().From(t1)
+		stars := AggregateNeighbors(s, NewStep(
+			From("users", "id"),
+			To("repos", "id"),
+			Edge(O2M, false, "repos", "owner_id"),
+		), "sum_num_stars", func(t *sql.SelectTable) string {
+			return sql.Sum(t.C("num_stars"))
+		})
+		size := AggregateNeighbors(s, NewStep(
+			From("users", "id"),
+			To("groups", "id"),
+			Edge(M2M, false, "user_groups", "user_id", "group_id"),
+		), "sum_size", func(t *sql.SelectTable) string {
+			return sql.Sum(t.C("size"))
+		})
+		s.Select(t1.C("country"), sql.As(sql.Count("*"), "count"), sql.As(sql.Sum(stars), "stars"), sql.As(sql.Sum(size), "size")).
+			GroupBy(t1.C("country")).
+			OrderBy(t1.C("country"))
+		var v []struct {
+			Country string `sql:"country"`
+			Count   int    `sql:"count"`
+			Stars   int    `sql:"stars"`
+			Size    int    `sql:"size"`
+		}
+		rows := &sql.Rows{}
+		query, args := s.Query()
+		require.NoError(t, drv.Query(ctx, query, args, rows))
+		defer rows.Close()
+		require.NoError(t, sql.ScanSlice(rows, &v))
+		require.Len(t, v, 2)
+		// Users are counted once, regardless of the number of their neighbors.
+		require.Equal(t, "dk", v[0].Country)
+		require.Equal(t, 2, v[0].Count)
+		require.Equal(t, 65, v[0].Stars)
+		require.Equal(t, 300, v[0].Size)
+		require.Equal(t, "il", v[1].Country)
+		require.Equal(t, 1, v[1].Count)
+		require.Equal(t, 0, v[1].Stars)
+		require.Equal(t, 100, v[1].Size)
+	})
+}
+
 func TestCreateNode(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1670,6 +1832,205 @@ func TestBatchCreate(t *testing.T) {
 	}
 }
 
+func TestUpsertEdges(t *testing.T) {
+	t.Run("DoNothing", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("email") VALUES ($1) ON CONFLICT ("email") DO NOTHING RETURNING "id"`)).
+			WithArgs("a8m@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(escape(`SELECT "id", "email" FROM "users" WHERE "email" IN ($1)`)).
+			WithArgs("a8m@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(5, "a8m@example.com"))
+		mock.ExpectExec(escape(`INSERT INTO "group_users" ("group_id", "user_id") VALUES ($1, $2) ON CONFLICT DO NOTHING`)).
+			WithArgs(2, 5).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "email", Type: field.TypeString, Value: "a8m@example.com"},
+			},
+			Edges: []*EdgeSpec{
+				{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2}, IDSpec: &FieldSpec{Column: "id"}}},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("email"),
+				sql.DoNothing(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, spec.ID.Value)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("NoEdges", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("email") VALUES ($1) ON CONFLICT ("email") DO NOTHING RETURNING "id"`)).
+			WithArgs("a8m@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		spec := &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+			Fields: []*FieldSpec{
+				{Column: "email", Type: field.TypeString, Value: "a8m@example.com"},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("email"),
+				sql.DoNothing(),
+			},
+		}
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.ErrorIs(t, err, stdsql.ErrNoRows, "conflicting nodes without edges are not queried")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Batch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`email`) VALUES (?), (?) ON DUPLICATE KEY UPDATE `email` = VALUES(`email`)")).
+			WithArgs("a8m@example.com", "nati@example.com").
+			WillReturnResult(sqlmock.NewResult(10, 3))
+		// The IDs are resolved using a single query, and the returned rows are
+		// matched to the nodes by their conflict values, regardless of their order.
+		mock.ExpectQuery(escape("SELECT `id`, `email` FROM `users` WHERE `email` IN (?, ?)")).
+			WithArgs("a8m@example.com", "nati@example.com").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(10, []byte("nati@example.com")).AddRow(5, []byte("a8m@example.com")))
+		// Pet 3 is already connected to the first user.
+		mock.ExpectExec(escape("UPDATE `pets` SET `owner_id` = ? WHERE `id` = ? AND `owner_id` IS NULL")).
+			WithArgs(5, 3).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(escape("SELECT COUNT(*) FROM `pets` WHERE `id` = ? AND `owner_id` = ?")).
+			WithArgs(3, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(1))
+		mock.ExpectExec(escape("UPDATE `pets` SET `owner_id` = ? WHERE `id` = ? AND `owner_id` IS NULL")).
+			WithArgs(10, 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		spec := &BatchCreateSpec{
+			Nodes: []*CreateSpec{
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "email", Type: field.TypeString, Value: "a8m@example.com"}},
+					Edges: []*EdgeSpec{
+						{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}, Target: &EdgeTarget{Nodes: []driver.Value{3}, IDSpec: &FieldSpec{Column: "id"}}},
+					},
+				},
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "email", Type: field.TypeString, Value: "nati@example.com"}},
+					Edges: []*EdgeSpec{
+						{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}, Target: &EdgeTarget{Nodes: []driver.Value{4}, IDSpec: &FieldSpec{Column: "id"}}},
+					},
+				},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("email"),
+				sql.ResolveWithNewValues(),
+			},
+		}
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, spec.Nodes[0].ID.Value)
+		require.EqualValues(t, 10, spec.Nodes[1].ID.Value)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MissingColumns", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`email`) VALUES (?) ON DUPLICATE KEY UPDATE `email` = VALUES(`email`)")).
+			WithArgs("a8m@example.com").
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectRollback()
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.MySQL, db), &BatchCreateSpec{
+			Nodes: []*CreateSpec{
+				{
+					Table:  "users",
+					ID:     &FieldSpec{Column: "id", Type: field.TypeInt},
+					Fields: []*FieldSpec{{Column: "email", Type: field.TypeString, Value: "a8m@example.com"}},
+					Edges: []*EdgeSpec{
+						{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}, Target: &EdgeTarget{Nodes: []driver.Value{3}, IDSpec: &FieldSpec{Column: "id"}}},
+					},
+				},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ResolveWithNewValues(),
+			},
+		})
+		require.EqualError(t, err, `sql/sqlgraph: missing conflict columns for adding edges to upserted nodes of table "users"`)
+		require.NoError(t, mock.ExpectationsWereMet())
+
+		// CreateNode behaves the same, in case the ID cannot be resolved from LAST_INSERT_ID.
+		db, mock, err = sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("INSERT INTO `users` (`email`, `id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `email` = VALUES(`email`), `id` = VALUES(`id`)")).
+			WithArgs("a8m@example.com", "a8m").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+		err = CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), &CreateSpec{
+			Table:  "users",
+			ID:     &FieldSpec{Column: "id", Type: field.TypeString, Value: "a8m"},
+			Fields: []*FieldSpec{{Column: "email", Type: field.TypeString, Value: "a8m@example.com"}},
+			Edges: []*EdgeSpec{
+				{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}, Target: &EdgeTarget{Nodes: []driver.Value{3}, IDSpec: &FieldSpec{Column: "id"}}},
+			},
+			OnConflict: []sql.ConflictOption{
+				sql.ResolveWithNewValues(),
+			},
+		})
+		require.EqualError(t, err, `sql/sqlgraph: missing conflict columns for adding edges to upserted nodes of table "users"`)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MultipleColumns", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name", "tenant") VALUES ($1, $2), ($3, $4), ($5, $6) ON CONFLICT ("tenant", "name") DO NOTHING RETURNING "id"`)).
+			WithArgs("a8m", 1, "a8m", 2, "a8m", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(escape(`SELECT "id", "tenant", "name" FROM "users" WHERE ("tenant" = $1 AND "name" = $2) OR ("tenant" = $3 AND "name" = $4)`)).
+			WithArgs(1, "a8m", 2, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant", "name"}).AddRow(2, int64(2), "a8m").AddRow(1, int64(1), "a8m"))
+		mock.ExpectExec(escape(`INSERT INTO "group_users" ("group_id", "user_id") VALUES ($1, $2), ($3, $4), ($5, $6) ON CONFLICT DO NOTHING`)).
+			WithArgs(7, 1, 7, 2, 7, 1).
+			WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectCommit()
+		node := func(tenant int) *CreateSpec {
+			return &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				Fields: []*FieldSpec{
+					{Column: "name", Type: field.TypeString, Value: "a8m"},
+					{Column: "tenant", Type: field.TypeInt, Value: tenant},
+				},
+				Edges: []*EdgeSpec{
+					{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{Nodes: []driver.Value{7}, IDSpec: &FieldSpec{Column: "id"}}},
+				},
+			}
+		}
+		spec := &BatchCreateSpec{
+			Nodes: []*CreateSpec{node(1), node(2), node(1)},
+			OnConflict: []sql.ConflictOption{
+				sql.ConflictColumns("tenant", "name"),
+				sql.DoNothing(),
+			},
+		}
+		err = BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, spec.Nodes[0].ID.Value)
+		require.EqualValues(t, 2, spec.Nodes[1].ID.Value)
+		require.EqualValues(t, 1, spec.Nodes[2].ID.Value)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 type user struct {
 	id    int
 	age   int
@@ -2372,6 +2733,150 @@ func TestDeleteNodesSchema(t *testing.T) {
 	require.Equal(t, 2, affected)
 }
 
+func TestUpdateNodesReturning(t *testing.T) {
+	var users []*user
+	spec := func() *UpdateSpec {
+		users = nil
+		return &UpdateSpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "age", "name"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+			},
+			Fields: FieldMut{
+				Add: []*FieldSpec{
+					{Column: "age", Type: field.TypeInt, Value: 1},
+				},
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ("name", "a8m"))
+			},
+			ScanValues: func(columns []string) ([]any, error) {
+				u := &user{}
+				users = append(users, u)
+				return u.values(columns)
+			},
+			Assign: func(columns []string, values []any) error {
+				return users[len(users)-1].assign(columns, values)
+			},
+		}
+	}
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`UPDATE "users" SET "age" = COALESCE("users"."age", 0) + $1 WHERE "name" = $2 RETURNING "id", "age", "name"`)).
+			WithArgs(1, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 31, "a8m").
+				AddRow(2, 21, "a8m"))
+		affected, err := UpdateNodesReturning(context.Background(), sql.OpenDB(dialect.Postgres, db), spec())
+		require.NoError(t, err)
+		require.Equal(t, 2, affected)
+		require.Equal(t, []*user{{id: 1, age: 31, name: "a8m"}, {id: 2, age: 21, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `name` = ? FOR UPDATE")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow(1).
+				AddRow(2))
+		mock.ExpectExec(escape("UPDATE `users` SET `age` = COALESCE(`users`.`age`, 0) + ? WHERE `id` IN (?, ?)")).
+			WithArgs(1, 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(escape("SELECT `id`, `age`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 31, "a8m").
+				AddRow(2, 21, "a8m"))
+		mock.ExpectCommit()
+		affected, err := UpdateNodesReturning(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+		require.NoError(t, err)
+		require.Equal(t, 2, affected)
+		require.Equal(t, []*user{{id: 1, age: 31, name: "a8m"}, {id: 2, age: 21, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `name` = ? FOR UPDATE")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+		affected, err := UpdateNodesReturning(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+		require.NoError(t, err)
+		require.Zero(t, affected)
+		require.Empty(t, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteNodesReturning(t *testing.T) {
+	var users []*user
+	spec := func() *DeleteSpec {
+		users = nil
+		return &DeleteSpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "age", "name"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ("name", "a8m"))
+			},
+			ScanValues: func(columns []string) ([]any, error) {
+				u := &user{}
+				users = append(users, u)
+				return u.values(columns)
+			},
+			Assign: func(columns []string, values []any) error {
+				return users[len(users)-1].assign(columns, values)
+			},
+		}
+	}
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape("DELETE FROM `users` WHERE `name` = ? RETURNING `id`, `age`, `name`")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 30, "a8m"))
+		affected, err := DeleteNodesReturning(context.Background(), sql.OpenDB(dialect.SQLite, db), spec())
+		require.NoError(t, err)
+		require.Equal(t, 1, affected)
+		require.Equal(t, []*user{{id: 1, age: 30, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `name` = ? FOR UPDATE")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow(1).
+				AddRow(2))
+		mock.ExpectQuery(escape("SELECT `id`, `age`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 30, "a8m").
+				AddRow(2, 20, "a8m"))
+		mock.ExpectExec(escape("DELETE FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+		affected, err := DeleteNodesReturning(context.Background(), sql.OpenDB(dialect.MySQL, db), spec())
+		require.NoError(t, err)
+		require.Equal(t, 2, affected)
+		require.Equal(t, []*user{{id: 1, age: 30, name: "a8m"}, {id: 2, age: 20, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestQueryNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2441,6 +2946,54 @@ func TestQueryNodes(t *testing.T) {
 	require.Equal(t, 3, n)
 }
 
+func TestSelectorErr(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	var (
+		ctx  = context.Background()
+		drv  = sql.OpenDB(dialect.SQLite, db)
+		node = &NodeSpec{
+			Table:   "hosts",
+			Columns: []string{"id", "addr"},
+			ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+		}
+		pred = func(s *sql.Selector) {
+			s.Where(sql.IPIn("addr", netip.MustParsePrefix("10.0.0.0/8")))
+		}
+		wantErr = `IPIn: unsupported dialect: "sqlite3"`
+	)
+	err = QueryNodes(ctx, drv, &QuerySpec{Node: node, Predicate: pred})
+	require.EqualError(t, err, wantErr)
+	_, err = CountNodes(ctx, drv, &QuerySpec{Node: node, Predicate: pred})
+	require.EqualError(t, err, wantErr)
+	_, err = DeleteNodes(ctx, drv, &DeleteSpec{Node: node, Predicate: pred})
+	require.EqualError(t, err, wantErr)
+	returning := &DeleteSpec{
+		Node:       node,
+		Predicate:  pred,
+		ScanValues: func([]string) ([]any, error) { return nil, nil },
+		Assign:     func([]string, []any) error { return nil },
+	}
+	_, err = DeleteNodesReturning(ctx, drv, returning)
+	require.EqualError(t, err, wantErr)
+	// On dialects without RETURNING, the nodes are locked within a transaction.
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	_, err = DeleteNodesReturning(ctx, sql.OpenDB(dialect.MySQL, db), returning)
+	require.EqualError(t, err, `IPIn: unsupported dialect: "mysql"`)
+
+	count := func(s *sql.Selector) string { return sql.Count("*") }
+	selector := sql.Dialect(dialect.SQLite).Select().From(sql.Table("hosts"))
+	pred(selector)
+	var v []struct{ Count int }
+	err = ScanSelect(ctx, drv, selector, nil, []func(*sql.Selector) string{count}, &v)
+	require.EqualError(t, err, wantErr)
+	err = ScanGroupBy(ctx, drv, selector, []string{"addr"}, []func(*sql.Selector) string{count}, &v)
+	require.EqualError(t, err, wantErr)
+	// No statements were executed.
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQueryNodesSchema(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2487,6 +3040,36 @@ func TestQueryNodesSchema(t *testing.T) {
 	require.Equal(t, &user{id: 3, age: 30, name: "a8m", edges: struct{ fk1, fk2 int }{1, 1}}, users[2])
 }
 
+func TestScanGroupBySelect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := sql.OpenDB(dialect.MySQL, db)
+	count := func(*sql.Selector) string { return sql.Count("*") }
+	mock.ExpectQuery(escape("SELECT `users`.`age`, COUNT(*) FROM `users` GROUP BY `users`.`age`")).
+		WillReturnRows(sqlmock.NewRows([]string{"age", "count"}).
+			AddRow(10, 1).
+			AddRow(20, 2))
+	var groups []struct {
+		Age   int `sql:"age"`
+		Count int `sql:"count"`
+	}
+	selector := sql.Dialect(dialect.MySQL).Select().From(sql.Table("users"))
+	err = ScanGroupBy(context.Background(), drv, selector, []string{"age"}, []func(*sql.Selector) string{count}, &groups)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Equal(t, 2, groups[1].Count)
+
+	mock.ExpectQuery(escape("SELECT COUNT(*) FROM `users`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).
+			AddRow(3))
+	var counts []int
+	selector = sql.Dialect(dialect.MySQL).Select().From(sql.Table("users"))
+	err = ScanSelect(context.Background(), drv, selector, nil, []func(*sql.Selector) string{count}, &counts)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, counts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQueryEdges(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -2562,6 +3145,198 @@ func TestQueryEdgesSchema(t *testing.T) {
 	require.Equal(t, [][]int64{{4, 5}, {4, 6}}, edges)
 }
 
+func TestRefreshView(t *testing.T) {
+	spec := &RefreshSpec{
+		Table:   "user_stats",
+		Columns: []string{"owner_id", "pets"},
+		ViewAs:  "SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id",
+		ViewFor: map[string]string{
+			dialect.SQLite: "SELECT owner_id, COUNT(id) AS pets FROM pets GROUP BY owner_id",
+		},
+	}
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectExec(escape(`REFRESH MATERIALIZED VIEW "user_stats"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(escape(`REFRESH MATERIALIZED VIEW CONCURRENTLY "mydb"."user_stats"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		drv := sql.OpenDB(dialect.Postgres, db)
+		require.NoError(t, RefreshView(context.Background(), drv, spec))
+		require.NoError(t, RefreshView(context.Background(), drv, &RefreshSpec{Table: "user_stats", Schema: "mydb", Concurrently: true}))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("DELETE FROM `user_stats`")).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(escape("INSERT INTO `user_stats` (`owner_id`, `pets`) SELECT `owner_id`, `pets` FROM (SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id) AS `v`")).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+		require.NoError(t, RefreshView(context.Background(), sql.OpenDB(dialect.MySQL, db), spec))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(escape("DELETE FROM `user_stats`")).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(escape("INSERT INTO `user_stats` (`owner_id`, `pets`) SELECT `owner_id`, `pets` FROM (SELECT owner_id, COUNT(id) AS pets FROM pets GROUP BY owner_id) AS `v`")).
+			WillReturnError(errors.New("boom"))
+		mock.ExpectRollback()
+		require.EqualError(t, RefreshView(context.Background(), sql.OpenDB(dialect.SQLite, db), spec), "boom")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MissingDefinition", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		err = RefreshView(context.Background(), sql.OpenDB(dialect.MySQL, db), &RefreshSpec{Table: "user_stats"})
+		require.EqualError(t, err, `sqlgraph: missing definition for refreshing view "user_stats"`)
+	})
+}
+
+func TestClaimNodes(t *testing.T) {
+	var users []*user
+	spec := func(d string) *ClaimSpec {
+		users = nil
+		spec := NewClaimSpec("users", []string{"id", "age", "name"}, NewFieldSpec("id", field.TypeInt))
+		spec.From = sql.Dialect(d).Select().From(sql.Table("users")).Where(sql.EQ("name", "a8m")).OrderBy("id")
+		spec.Limit = 2
+		spec.SetField("age", field.TypeInt, 30)
+		spec.ScanValues = func(columns []string) ([]any, error) {
+			u := &user{}
+			users = append(users, u)
+			return u.values(columns)
+		}
+		spec.Assign = func(columns []string, values []any) error {
+			return users[len(users)-1].assign(columns, values)
+		}
+		return spec
+	}
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`UPDATE "users" SET "age" = $1 WHERE "id" IN (SELECT "users"."id" FROM "users" WHERE "name" = $2 ORDER BY "id" LIMIT 2 FOR UPDATE OF "users" SKIP LOCKED) RETURNING "id", "age", "name"`)).
+			WithArgs(30, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 30, "a8m").
+				AddRow(2, 30, "a8m"))
+		claimed, err := ClaimNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec(dialect.Postgres))
+		require.NoError(t, err)
+		require.Equal(t, 2, claimed)
+		require.Equal(t, []*user{{id: 1, age: 30, name: "a8m"}, {id: 2, age: 30, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("SQLite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape("UPDATE `users` SET `age` = ? WHERE `id` IN (SELECT `users`.`id` FROM `users` WHERE `name` = ? ORDER BY `id` LIMIT 2) RETURNING `id`, `age`, `name`")).
+			WithArgs(30, "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 30, "a8m"))
+		claimed, err := ClaimNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec(dialect.SQLite))
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+		require.Equal(t, []*user{{id: 1, age: 30, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `users`.`id` FROM `users` WHERE `name` = ? ORDER BY `id` LIMIT 2 FOR UPDATE OF `users` SKIP LOCKED")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow(1).
+				AddRow(2))
+		mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` IN (?, ?)")).
+			WithArgs(30, 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(escape("SELECT `id`, `age`, `name` FROM `users` WHERE `id` IN (?, ?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+				AddRow(1, 30, "a8m").
+				AddRow(2, 30, "a8m"))
+		mock.ExpectCommit()
+		claimed, err := ClaimNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec(dialect.MySQL))
+		require.NoError(t, err)
+		require.Equal(t, 2, claimed)
+		require.Equal(t, []*user{{id: 1, age: 30, name: "a8m"}, {id: 2, age: 30, name: "a8m"}}, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `users`.`id` FROM `users` WHERE `name` = ? ORDER BY `id` LIMIT 2 FOR UPDATE OF `users` SKIP LOCKED")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+		claimed, err := ClaimNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec(dialect.MySQL))
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+		require.Empty(t, users)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("Lease", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`UPDATE "users" SET "age" = $1, "lease" = CURRENT_TIMESTAMP + $2 * INTERVAL '1 microsecond' WHERE "id" IN (SELECT "users"."id" FROM "users" WHERE "name" = $3 AND ("users"."lease" IS NULL OR "users"."lease" <= CURRENT_TIMESTAMP) ORDER BY "id" LIMIT 2 FOR UPDATE OF "users" SKIP LOCKED) RETURNING "id", "age", "name"`)).
+			WithArgs(30, int64(30_000_000), "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}))
+		s := spec(dialect.Postgres)
+		s.SetLease("lease", 30*time.Second)
+		claimed, err := ClaimNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), s)
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+		require.NoError(t, mock.ExpectationsWereMet())
+
+		db, mock, err = sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape("SELECT `users`.`id` FROM `users` WHERE `name` = ? AND (`users`.`lease` IS NULL OR `users`.`lease` <= CURRENT_TIMESTAMP(6)) ORDER BY `id` LIMIT 2 FOR UPDATE OF `users` SKIP LOCKED")).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(escape("UPDATE `users` SET `age` = ?, `lease` = CURRENT_TIMESTAMP(6) + INTERVAL ? MICROSECOND WHERE `id` = ?")).
+			WithArgs(30, int64(30_000_000), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(escape("SELECT `id`, `age`, `name` FROM `users` WHERE `id` = ?")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).AddRow(1, 30, "a8m"))
+		mock.ExpectCommit()
+		s = spec(dialect.MySQL)
+		s.SetLease("lease", 30*time.Second)
+		claimed, err = ClaimNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), s)
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed)
+		require.NoError(t, mock.ExpectationsWereMet())
+
+		// SQLite uses the clock of the client.
+		db, mock, err = sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape("UPDATE `users` SET `age` = ?, `lease` = ? WHERE `id` IN (SELECT `users`.`id` FROM `users` WHERE `name` = ? AND (`users`.`lease` IS NULL OR `users`.`lease` <= ?) ORDER BY `id` LIMIT 2) RETURNING `id`, `age`, `name`")).
+			WithArgs(30, sqlmock.AnyArg(), "a8m", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}))
+		s = spec(dialect.SQLite)
+		s.SetLease("lease", 30*time.Second)
+		claimed, err = ClaimNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), s)
+		require.NoError(t, err)
+		require.Zero(t, claimed)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("InvalidLimit", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		s := spec(dialect.MySQL)
+		s.Limit = 0
+		_, err = ClaimNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), s)
+		require.EqualError(t, err, `sql/sqlgraph: invalid limit 0 for claim table "users"`)
+	})
+}
+
 func TestIsConstraintError(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -384,6 +384,121 @@ func (f MutateFunc) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	return f(ctx, m)
 }
 
+// NamedHook is a Hook that is registered with a name and a priority.
+// Unlike anonymous hooks, named hooks can be listed and removed from
+// the client at runtime, and skipped for specific calls using SkipHooks.
+//
+//	client.UseNamed(ent.NamedHook{
+//		Name:     "audit",
+//		Priority: 10,
+//		Hook:     AuditHook,
+//	})
+type NamedHook struct {
+	// Name identifies the hook in the registry and in SkipHooks.
+	Name string
+	// Priority controls the execution order of the hook. Hooks with a
+	// higher priority are executed first (i.e. wrap hooks with a lower
+	// priority). Hooks with equal priority keep their registration order.
+	Priority int
+	// Hook is the underlying mutation hook.
+	Hook Hook
+}
+
+// Skippable returns the underlying hook wrapped with a check that bypasses
+// it for mutations that were executed with a context that skips h.Name.
+func (h NamedHook) Skippable() Hook {
+	return func(next Mutator) Mutator {
+		hk := h.Hook(next)
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			if HookSkipped(ctx, h.Name) {
+				return next.Mutate(ctx, m)
+			}
+			return hk.Mutate(ctx, m)
+		})
+	}
+}
+
+type (
+	// BulkMutation represents a batch of entities that is mutated by a single
+	// bulk operation. For CreateBulk (OpCreate), it holds the mutations of all
+	// created entities. For predicate-based Update and Delete (OpUpdate and
+	// OpDelete), it holds the shared mutation and the resolved IDs of the
+	// entities that are affected by the operation.
+	BulkMutation struct {
+		// Op is the operation of the batch.
+		Op Op
+		// Type is the schema type of the batch.
+		Type string
+		// Mutations holds the mutations of the batch. For OpCreate it holds one mutation
+		// per created entity, and for OpUpdate and OpDelete it holds a single mutation.
+		Mutations []Mutation
+		// IDs holds the IDs of the entities that are affected by OpUpdate and OpDelete
+		// operations. Hooks can shrink this list in order to limit the affected entities.
+		IDs []Value
+	}
+
+	// BulkMutator is the interface that wraps the MutateBulk method.
+	BulkMutator interface {
+		// MutateBulk applies the given bulk mutation on the graph. The returned
+		// ent.Value is changing according to the mutation operation:
+		//
+		// OpCreate, the returned value is the created nodes ([]*T).
+		// OpUpdate, OpDelete, the returned value is the amount of affected nodes (int).
+		//
+		MutateBulk(context.Context, *BulkMutation) (Value, error)
+	}
+
+	// The BulkMutateFunc type is an adapter to allow the use of ordinary
+	// function as BulkMutator. If f is a function with the appropriate signature,
+	// BulkMutateFunc(f) is a BulkMutator that calls f.
+	BulkMutateFunc func(context.Context, *BulkMutation) (Value, error)
+
+	// BulkHook defines the "bulk mutation middleware". Unlike Hook, which is executed
+	// once for each mutation, BulkHook is executed once for the whole batch. For example:
+	//
+	//	hook := func(next ent.BulkMutator) ent.BulkMutator {
+	//		return ent.BulkMutateFunc(func(ctx context.Context, m *ent.BulkMutation) (ent.Value, error) {
+	//			fmt.Printf("Type: %s, Operation: %s, IDs: %v\n", m.Type, m.Op, m.IDs)
+	//			return next.MutateBulk(ctx, m)
+	//		})
+	//	}
+	//
+	BulkHook func(BulkMutator) BulkMutator
+)
+
+// MutateBulk calls f(ctx, m).
+func (f BulkMutateFunc) MutateBulk(ctx context.Context, m *BulkMutation) (Value, error) {
+	return f(ctx, m)
+}
+
+type skipHooksKey struct{}
+
+// SkipHooks returns a new context that skips the execution of the named hooks
+// with the given names. Names are accumulated with the ones that were already
+// skipped in the parent context, if any.
+//
+//	ctx = ent.SkipHooks(ctx, "audit", "notify")
+//	client.User.Delete().Where(...).Exec(ctx)
+func SkipHooks(parent context.Context, names ...string) context.Context {
+	prev, _ := parent.Value(skipHooksKey{}).(map[string]struct{})
+	skip := make(map[string]struct{}, len(prev)+len(names))
+	for name := range prev {
+		skip[name] = struct{}{}
+	}
+	for _, name := range names {
+		skip[name] = struct{}{}
+	}
+	return context.WithValue(parent, skipHooksKey{}, skip)
+}
+
+// HookSkipped reports whether the named hook with the given name
+// should be skipped for mutations executed with the given context.
+func HookSkipped(ctx context.Context, name string) bool {
+	skip, _ := ctx.Value(skipHooksKey{}).(map[string]struct{})
+	_, ok := skip[name]
+	return ok
+}
+
 type (
 	// Query represents a query builder of an entity. It is
 	// usually one of the following types: <T>Query.
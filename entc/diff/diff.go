@@ -0,0 +1,572 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package diff computes the changelog between two states of an ent/schema package.
+// The states are usually loaded from the schema snapshots (see the "schema/snapshot"
+// feature flag) that were generated at two different revisions of the project.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/entc/internal"
+	"entgo.io/ent/entc/load"
+)
+
+// Kind describes the kind of change.
+type Kind string
+
+// List of change kinds.
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+// Object describes the schema object that was changed.
+type Object string
+
+// List of schema objects.
+const (
+	ObjectType       Object = "type"
+	ObjectField      Object = "field"
+	ObjectEdge       Object = "edge"
+	ObjectIndex      Object = "index"
+	ObjectAnnotation Object = "annotation"
+)
+
+// Change describes a single change between two schema states.
+type Change struct {
+	// Kind of the change.
+	Kind Kind `json:"kind"`
+	// Object that was changed.
+	Object Object `json:"object"`
+	// Type is the name of the schema type the object belongs to.
+	Type string `json:"type"`
+	// Name of the changed object. Empty for types.
+	Name string `json:"name,omitempty"`
+	// Desc describes the change (e.g. "optional changed from true to false").
+	Desc string `json:"desc"`
+	// APIBreaking reports if the change breaks the generated Go code,
+	// e.g. types, fields or methods that were removed or changed.
+	APIBreaking bool `json:"api_breaking"`
+	// DBBreaking reports if the change requires a destructive database
+	// migration, or a migration that may fail on existing data.
+	DBBreaking bool `json:"db_breaking"`
+	// Review reports if the impact of the change is not known, and it
+	// should be reviewed manually. For example, changes of annotations
+	// that are not known to this package.
+	Review bool `json:"review"`
+}
+
+// Safe reports if the change is neither API-breaking nor DB-breaking,
+// and it does not require a manual review.
+func (c *Change) Safe() bool {
+	return !c.APIBreaking && !c.DBBreaking && !c.Review
+}
+
+// Class returns the classification of the change as a string.
+func (c *Change) Class() string {
+	var class []string
+	if c.APIBreaking {
+		class = append(class, "api-breaking")
+	}
+	if c.DBBreaking {
+		class = append(class, "db-breaking")
+	}
+	if c.Review {
+		class = append(class, "review")
+	}
+	if len(class) == 0 {
+		return "safe"
+	}
+	return strings.Join(class, ", ")
+}
+
+// String implements the fmt.Stringer interface.
+func (c *Change) String() string {
+	s := string(c.Object)
+	if c.Name != "" {
+		s += fmt.Sprintf(" %q", c.Name)
+	}
+	return fmt.Sprintf("%s: %s (%s)", s, c.Desc, c.Class())
+}
+
+// Changes is a list of changes.
+type Changes []*Change
+
+// APIBreaking reports if one of the changes is API-breaking.
+func (cs Changes) APIBreaking() bool {
+	return slices.ContainsFunc(cs, func(c *Change) bool { return c.APIBreaking })
+}
+
+// DBBreaking reports if one of the changes is DB-breaking.
+func (cs Changes) DBBreaking() bool {
+	return slices.ContainsFunc(cs, func(c *Change) bool { return c.DBBreaking })
+}
+
+// Review reports if one of the changes requires a manual review.
+func (cs Changes) Review() bool {
+	return slices.ContainsFunc(cs, func(c *Change) bool { return c.Review })
+}
+
+// impact holds the classification of a change.
+type impact uint
+
+const (
+	safe        impact = 0
+	apiBreaking impact = 1 << iota
+	dbBreaking
+	review
+)
+
+// ReadSnapshot reads the schemas stored in the content of a snapshot file
+// (e.g. ent/internal/schema.go) that was read from the given path.
+func ReadSnapshot(path string, buf []byte) ([]*load.Schema, error) {
+	snap, err := internal.ParseSnapshot(path, buf)
+	if err != nil {
+		return nil, err
+	}
+	return snap.Schemas, nil
+}
+
+// Schemas returns the changes between the old and the new schemas. Changes
+// are sorted by type name, and keep the order of the objects in each type.
+func Schemas(old, new []*load.Schema) Changes {
+	var (
+		d    = &differ{}
+		olds = make(map[string]*load.Schema, len(old))
+	)
+	for _, s := range old {
+		olds[s.Name] = s
+	}
+	for _, s := range new {
+		o, ok := olds[s.Name]
+		if !ok {
+			d.add(Added, ObjectType, s.Name, "", "type was added", safe)
+			continue
+		}
+		delete(olds, s.Name)
+		d.schema(o, s)
+	}
+	for _, s := range old {
+		if _, ok := olds[s.Name]; ok {
+			d.add(Removed, ObjectType, s.Name, "", "type was removed", apiBreaking|dbBreaking)
+		}
+	}
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Type < d.changes[j].Type
+	})
+	return d.changes
+}
+
+// differ collects the changes between two schema states.
+type differ struct {
+	changes Changes
+}
+
+func (d *differ) add(k Kind, o Object, typ, name, desc string, i impact) {
+	d.changes = append(d.changes, &Change{
+		Kind:        k,
+		Object:      o,
+		Type:        typ,
+		Name:        name,
+		Desc:        desc,
+		APIBreaking: i&apiBreaking != 0,
+		DBBreaking:  i&dbBreaking != 0,
+		Review:      i&review != 0,
+	})
+}
+
+// schema collects the changes between two states of a schema type.
+func (d *differ) schema(old, new *load.Schema) {
+	if old.View != new.View {
+		d.add(Changed, ObjectType, new.Name, "", changed("view", old.View, new.View), apiBreaking|dbBreaking)
+	}
+	if old.Config.Table != new.Config.Table {
+		d.add(Changed, ObjectType, new.Name, "", changed("table", old.Config.Table, new.Config.Table), dbBreaking)
+	}
+	d.fields(new.Name, old.Fields, new.Fields)
+	d.edges(new.Name, old.Edges, new.Edges)
+	d.indexes(new.Name, old.Indexes, new.Indexes)
+	for _, c := range annotations(old.Annotations, new.Annotations) {
+		d.add(c.kind, ObjectAnnotation, new.Name, c.name, "annotation was "+c.desc(), c.impact)
+	}
+}
+
+// fields collects the changes between two states of the fields of a type.
+func (d *differ) fields(typ string, old, new []*load.Field) {
+	olds := make(map[string]*load.Field, len(old))
+	for _, f := range old {
+		olds[f.Name] = f
+	}
+	for _, f := range new {
+		o, ok := olds[f.Name]
+		if !ok {
+			switch {
+			// Existing rows are filled with NULL or with the default value of the column.
+			case f.Optional, f.Default && f.DefaultValue != nil:
+				d.add(Added, ObjectField, typ, f.Name, "field was added", safe)
+			default:
+				d.add(Added, ObjectField, typ, f.Name, "required field without a default value was added", dbBreaking)
+			}
+			continue
+		}
+		delete(olds, f.Name)
+		d.field(typ, o, f)
+	}
+	for _, f := range old {
+		if _, ok := olds[f.Name]; ok {
+			d.add(Removed, ObjectField, typ, f.Name, "field was removed", apiBreaking|dbBreaking)
+		}
+	}
+}
+
+// field collects the changes between two states of a field.
+func (d *differ) field(typ string, old, new *load.Field) {
+	change := func(desc string, i impact) {
+		d.add(Changed, ObjectField, typ, new.Name, desc, i)
+	}
+	if o, n := old.Info.String(), new.Info.String(); o != n || old.Info.Type != new.Info.Type {
+		change(fmt.Sprintf("type changed from %s to %s", o, n), apiBreaking|dbBreaking)
+	}
+	if old.Optional != new.Optional {
+		// Optional fields become required, and their columns become NOT NULL.
+		change(changed("optional", old.Optional, new.Optional), when(old.Optional, apiBreaking|dbBreaking))
+	}
+	if old.Nillable != new.Nillable {
+		change(changed("nillable", old.Nillable, new.Nillable), apiBreaking)
+	}
+	if old.Unique != new.Unique {
+		// Adding a unique constraint fails on duplicate values.
+		change(changed("unique", old.Unique, new.Unique), when(new.Unique, dbBreaking))
+	}
+	if old.Immutable != new.Immutable {
+		change(changed("immutable", old.Immutable, new.Immutable), when(new.Immutable, apiBreaking))
+	}
+	if old.Default != new.Default {
+		change(changed("default", old.Default, new.Default), when(old.Default, apiBreaking))
+	} else if !reflect.DeepEqual(old.DefaultValue, new.DefaultValue) {
+		change(changed("default value", old.DefaultValue, new.DefaultValue), safe)
+	}
+	if old.UpdateDefault != new.UpdateDefault {
+		change(changed("update default", old.UpdateDefault, new.UpdateDefault), when(old.UpdateDefault, apiBreaking))
+	}
+	if old.StorageKey != new.StorageKey {
+		change(changed("storage key", old.StorageKey, new.StorageKey), dbBreaking)
+	}
+	if o, n := size(old.Size), size(new.Size); o != n {
+		change(changed("size", o, n), when(n != 0 && (o == 0 || n < o), dbBreaking))
+	}
+	if !reflect.DeepEqual(old.SchemaType, new.SchemaType) {
+		change(changed("schema type", old.SchemaType, new.SchemaType), dbBreaking)
+	}
+	olds := make(map[string]bool, len(old.Enums))
+	for _, e := range old.Enums {
+		olds[e.V] = true
+	}
+	for _, e := range new.Enums {
+		if !olds[e.V] {
+			change(fmt.Sprintf("enum value %q was added", e.V), safe)
+		}
+		delete(olds, e.V)
+	}
+	for _, e := range old.Enums {
+		if olds[e.V] {
+			change(fmt.Sprintf("enum value %q was removed", e.V), apiBreaking|dbBreaking)
+		}
+	}
+	if old.Sensitive != new.Sensitive {
+		change(changed("sensitive", old.Sensitive, new.Sensitive), safe)
+	}
+	if old.Deprecated != new.Deprecated {
+		change(changed("deprecated", old.Deprecated, new.Deprecated), safe)
+	}
+	for _, c := range annotations(old.Annotations, new.Annotations) {
+		change(fmt.Sprintf("annotation %q was %s", c.name, c.desc()), c.impact)
+	}
+}
+
+// edges collects the changes between two states of the edges of a type.
+func (d *differ) edges(typ string, old, new []*load.Edge) {
+	olds := make(map[string]*load.Edge, len(old))
+	for _, e := range old {
+		olds[e.Name] = e
+	}
+	for _, e := range new {
+		o, ok := olds[e.Name]
+		if !ok {
+			// Required edges are stored in NOT NULL foreign-key columns.
+			d.add(Added, ObjectEdge, typ, e.Name, "edge was added", when(e.Required, dbBreaking))
+			continue
+		}
+		delete(olds, e.Name)
+		d.edge(typ, o, e)
+	}
+	for _, e := range old {
+		if _, ok := olds[e.Name]; ok {
+			d.add(Removed, ObjectEdge, typ, e.Name, "edge was removed", apiBreaking|dbBreaking)
+		}
+	}
+}
+
+// edge collects the changes between two states of an edge.
+func (d *differ) edge(typ string, old, new *load.Edge) {
+	change := func(desc string, i impact) {
+		d.add(Changed, ObjectEdge, typ, new.Name, desc, i)
+	}
+	if old.Type != new.Type {
+		change(changed("type", old.Type, new.Type), apiBreaking|dbBreaking)
+	}
+	if old.Unique != new.Unique {
+		change(changed("unique", old.Unique, new.Unique), apiBreaking|dbBreaking)
+	}
+	if old.Inverse != new.Inverse {
+		change(changed("inverse", old.Inverse, new.Inverse), apiBreaking|dbBreaking)
+	}
+	if o, n := refName(old), refName(new); o != n {
+		change(changed("reference", o, n), dbBreaking)
+	}
+	if old.Required != new.Required {
+		change(changed("required", old.Required, new.Required), when(new.Required, dbBreaking))
+	}
+	if old.Immutable != new.Immutable {
+		change(changed("immutable", old.Immutable, new.Immutable), when(new.Immutable, apiBreaking))
+	}
+	if old.Field != new.Field {
+		change(changed("field", old.Field, new.Field), apiBreaking|dbBreaking)
+	}
+	if !reflect.DeepEqual(old.Through, new.Through) {
+		change("edge schema (through) was changed", apiBreaking|dbBreaking)
+	}
+	if !reflect.DeepEqual(old.StorageKey, new.StorageKey) {
+		change("storage key was changed", dbBreaking)
+	}
+	for _, c := range annotations(old.Annotations, new.Annotations) {
+		change(fmt.Sprintf("annotation %q was %s", c.name, c.desc()), c.impact)
+	}
+}
+
+// indexes collects the changes between two states of the indexes of a type.
+func (d *differ) indexes(typ string, old, new []*load.Index) {
+	olds := make(map[string]*load.Index, len(old))
+	for _, idx := range old {
+		olds[indexName(idx)] = idx
+	}
+	for _, idx := range new {
+		name := indexName(idx)
+		o, ok := olds[name]
+		if !ok {
+			// Adding a unique index fails on duplicate values.
+			d.add(Added, ObjectIndex, typ, name, "index was added", when(idx.Unique, dbBreaking))
+			continue
+		}
+		delete(olds, name)
+		if o.Unique != idx.Unique {
+			d.add(Changed, ObjectIndex, typ, name, changed("unique", o.Unique, idx.Unique), when(idx.Unique, dbBreaking))
+		}
+		if o.StorageKey != idx.StorageKey {
+			d.add(Changed, ObjectIndex, typ, name, changed("storage key", o.StorageKey, idx.StorageKey), safe)
+		}
+		for _, c := range annotations(o.Annotations, idx.Annotations) {
+			// Indexes are recreated, and unique ones may fail on duplicate values.
+			if c.name == entsqlIndexes {
+				c.impact |= when(idx.Unique, dbBreaking)
+			}
+			d.add(Changed, ObjectIndex, typ, name, fmt.Sprintf("annotation %q was %s", c.name, c.desc()), c.impact)
+		}
+	}
+	for _, idx := range old {
+		if name := indexName(idx); olds[name] != nil {
+			d.add(Removed, ObjectIndex, typ, name, "index was removed", safe)
+		}
+	}
+}
+
+// annotationChange describes a change of a single annotation.
+type annotationChange struct {
+	kind   Kind
+	name   string
+	keys   []string // Changed keys of known annotations.
+	impact impact
+}
+
+// desc describes the change, and the keys that were changed.
+func (c annotationChange) desc() string {
+	if len(c.keys) == 0 {
+		return string(c.kind)
+	}
+	return fmt.Sprintf("%s (%s)", c.kind, strings.Join(c.keys, ", "))
+}
+
+// Names of the builtin annotations.
+const (
+	entsql        = "EntSQL"
+	entsqlIndexes = "EntSQLIndexes"
+)
+
+// annotationKeys holds the classification of the keys of the known annotations. Each
+// function returns the impact of changing the key from the old value to the new one,
+// where nil values stand for keys that were not set. Changes of unknown annotations,
+// or unknown keys of known annotations, require a manual review.
+var annotationKeys = map[string]func(key string, old, new any) impact{
+	entsql: func(key string, old, new any) impact {
+		switch key {
+		case "default", "default_expr", "default_exprs", "with_comments", "incremental", "increment_start":
+			return safe
+		case "size":
+			o, n := number(old), number(new)
+			return when(n != 0 && (o == 0 || n < o), dbBreaking)
+		case "check", "checks":
+			// Adding constraints fails on rows that violate them.
+			return when(new != nil, dbBreaking)
+		// Tables are renamed or moved, and columns are converted.
+		case "table", "schema", "charset", "collation", "materialized":
+			return dbBreaking
+		// Changes the behavior of the database (e.g. ON DELETE), or the managed objects.
+		default:
+			return review
+		}
+	},
+	// Index annotations are classified by the index they are defined on.
+	entsqlIndexes: func(string, any, any) impact {
+		return safe
+	},
+	// field.Annotation.
+	"Fields": func(key string, _, _ any) impact {
+		switch key {
+		case "StructTag":
+			return safe
+		case "ID":
+			return apiBreaking | dbBreaking
+		default:
+			return review
+		}
+	},
+	// edge.Annotation, field.RenameAnnotation and schema.CommentAnnotation.
+	"Edges":       func(string, any, any) impact { return safe },
+	"FieldRename": func(string, any, any) impact { return safe },
+	"Comment":     func(string, any, any) impact { return safe },
+}
+
+// annotations returns the changes between two states of annotations, sorted by name.
+func annotations(old, new map[string]any) []annotationChange {
+	var cs []annotationChange
+	for name, v := range new {
+		switch o, ok := old[name]; {
+		case !ok:
+			cs = append(cs, annotation(Added, name, nil, v))
+		case !reflect.DeepEqual(o, v):
+			cs = append(cs, annotation(Changed, name, o, v))
+		}
+	}
+	for name, v := range old {
+		if _, ok := new[name]; !ok {
+			cs = append(cs, annotation(Removed, name, v, nil))
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].name < cs[j].name })
+	return cs
+}
+
+// annotation classifies the change of a single annotation by the keys that were changed.
+func annotation(k Kind, name string, old, new any) annotationChange {
+	c := annotationChange{kind: k, name: name, impact: review}
+	classify, ok := annotationKeys[name]
+	if !ok {
+		return c
+	}
+	o, ok1 := object(old)
+	n, ok2 := object(new)
+	if !ok1 || !ok2 {
+		return c
+	}
+	c.impact = safe
+	for key, v := range n {
+		if !reflect.DeepEqual(o[key], v) {
+			c.keys = append(c.keys, key)
+		}
+	}
+	for key := range o {
+		if _, ok := n[key]; !ok {
+			c.keys = append(c.keys, key)
+		}
+	}
+	sort.Strings(c.keys)
+	for _, key := range c.keys {
+		c.impact |= classify(key, o[key], n[key])
+	}
+	return c
+}
+
+// object returns the JSON object representation of an annotation.
+// Annotations that were not set are represented as empty objects.
+func object(v any) (map[string]any, bool) {
+	m := make(map[string]any)
+	if v == nil {
+		return m, true
+	}
+	buf, err := json.Marshal(v)
+	if err != nil || json.Unmarshal(buf, &m) != nil {
+		return nil, false
+	}
+	// Keys with zero values are equivalent to keys that were not set.
+	for k, v := range m {
+		if v == nil || reflect.ValueOf(v).IsZero() {
+			delete(m, k)
+		}
+	}
+	return m, true
+}
+
+// number returns the numeric value of an annotation key, or 0 if it is not a number.
+func number(v any) int64 {
+	if f, ok := v.(float64); ok {
+		return int64(f)
+	}
+	return 0
+}
+
+// indexName returns the name that identifies an index in its type.
+// For example, "owner,name" for an index on the "owner" edge and
+// the "name" field.
+func indexName(idx *load.Index) string {
+	return strings.Join(append(slices.Clone(idx.Edges), idx.Fields...), ",")
+}
+
+// refName returns the name of the edge that is referenced by an inverse edge.
+func refName(e *load.Edge) string {
+	if e.Ref != nil {
+		return e.Ref.Name
+	}
+	return e.RefName
+}
+
+func size(s *int64) int64 {
+	if s == nil {
+		return 0
+	}
+	return *s
+}
+
+// when returns i if cond is true, and safe otherwise.
+func when(cond bool, i impact) impact {
+	if cond {
+		return i
+	}
+	return safe
+}
+
+func changed(attr string, old, new any) string {
+	format := "%s changed from %v to %v"
+	if _, ok := old.(string); ok {
+		format = "%s changed from %q to %q"
+	}
+	return fmt.Sprintf(format, attr, old, new)
+}
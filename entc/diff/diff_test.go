@@ -0,0 +1,180 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas(t *testing.T) {
+	old := []*load.Schema{
+		{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+				{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+				{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{"Admin", "admin"}, {"User", "user"}}},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "groups", Type: "Group"},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"name"}},
+			},
+		},
+		{Name: "Group"},
+	}
+	new := []*load.Schema{
+		{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt64}},
+				{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []struct{ N, V string }{{"Admin", "admin"}, {"Owner", "owner"}}},
+				{Name: "bio", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+				{Name: "active", Info: &field.TypeInfo{Type: field.TypeBool}, Default: true, DefaultValue: true},
+				{Name: "code", Info: &field.TypeInfo{Type: field.TypeString}},
+			},
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet", Unique: true},
+			},
+			Indexes: []*load.Index{
+				{Fields: []string{"name"}, Unique: true},
+			},
+			Annotations: map[string]any{"EntSQL": map[string]any{"table": "users"}},
+		},
+		{Name: "Pet"},
+	}
+	var lines []string
+	for _, c := range Schemas(old, new) {
+		lines = append(lines, fmt.Sprintf("%s %s %s", c.Type, c.Kind, c))
+	}
+	require.Equal(t, []string{
+		`Group removed type: type was removed (api-breaking, db-breaking)`,
+		`Pet added type: type was added (safe)`,
+		`User changed field "name": optional changed from false to true (safe)`,
+		`User changed field "age": type changed from int to int64 (api-breaking, db-breaking)`,
+		`User changed field "nickname": optional changed from true to false (api-breaking, db-breaking)`,
+		`User changed field "role": enum value "owner" was added (safe)`,
+		`User changed field "role": enum value "user" was removed (api-breaking, db-breaking)`,
+		`User added field "bio": field was added (safe)`,
+		`User added field "active": field was added (safe)`,
+		`User added field "code": required field without a default value was added (db-breaking)`,
+		`User removed field "email": field was removed (api-breaking, db-breaking)`,
+		`User changed edge "pets": unique changed from false to true (api-breaking, db-breaking)`,
+		`User removed edge "groups": edge was removed (api-breaking, db-breaking)`,
+		`User changed index "name": unique changed from false to true (db-breaking)`,
+		`User added annotation "EntSQL": annotation was added (table) (db-breaking)`,
+	}, lines)
+	require.Empty(t, Schemas(old, old))
+}
+
+func TestSchemas_Annotations(t *testing.T) {
+	user := func(ant map[string]any, fant map[string]any) []*load.Schema {
+		return []*load.Schema{
+			{
+				Name:        "User",
+				Fields:      []*load.Field{{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: fant}},
+				Edges:       []*load.Edge{{Name: "pets", Type: "Pet"}},
+				Indexes:     []*load.Index{{Fields: []string{"name"}, Unique: true, Annotations: fant}},
+				Annotations: ant,
+			},
+		}
+	}
+	tests := []struct {
+		old, new []*load.Schema
+		want     []string
+	}{
+		{
+			old: user(nil, map[string]any{"EntSQL": map[string]any{"size": 10, "default": "a8m"}}),
+			new: user(nil, map[string]any{"EntSQL": map[string]any{"size": 20, "default": "ent"}}),
+			want: []string{
+				`field "name": annotation "EntSQL" was changed (default, size) (safe)`,
+				`index "name": annotation "EntSQL" was changed (default, size) (safe)`,
+			},
+		},
+		{
+			old: user(nil, map[string]any{"EntSQL": map[string]any{"size": 20, "check": "name <> ''"}}),
+			new: user(nil, map[string]any{"EntSQL": map[string]any{"size": 10}}),
+			want: []string{
+				`field "name": annotation "EntSQL" was changed (check, size) (db-breaking)`,
+				`index "name": annotation "EntSQL" was changed (check, size) (db-breaking)`,
+			},
+		},
+		{
+			old: user(map[string]any{"EntSQL": map[string]any{"table": "users"}}, nil),
+			new: user(map[string]any{"EntSQL": map[string]any{"table": "users", "charset": "utf8mb4", "on_delete": "CASCADE"}}, nil),
+			want: []string{
+				`annotation "EntSQL": annotation was changed (charset, on_delete) (db-breaking, review)`,
+			},
+		},
+		{
+			old: user(map[string]any{"Fields": map[string]any{"StructTag": map[string]any{"name": `json:"-"`}}, "Comment": map[string]any{"Text": "User"}}, nil),
+			new: user(map[string]any{"Fields": map[string]any{"ID": []any{"user_id", "pet_id"}}}, nil),
+			want: []string{
+				`annotation "Comment": annotation was removed (Text) (safe)`,
+				`annotation "Fields": annotation was changed (ID, StructTag) (api-breaking, db-breaking)`,
+			},
+		},
+		{
+			old: user(nil, nil),
+			new: user(nil, map[string]any{"EntSQLIndexes": map[string]any{"where": "name <> ''"}, "EntGQL": map[string]any{"OrderField": "NAME"}}),
+			want: []string{
+				`field "name": annotation "EntGQL" was added (review)`,
+				`field "name": annotation "EntSQLIndexes" was added (where) (safe)`,
+				`index "name": annotation "EntGQL" was added (review)`,
+				`index "name": annotation "EntSQLIndexes" was added (where) (db-breaking)`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		var lines []string
+		for _, c := range Schemas(tt.old, tt.new) {
+			lines = append(lines, c.String())
+		}
+		require.Equal(t, tt.want, lines)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	cs := Changes{{Kind: Added, Object: ObjectType, Type: "User", Desc: "type was added"}}
+	require.True(t, cs[0].Safe())
+	require.False(t, cs.APIBreaking())
+	require.False(t, cs.DBBreaking())
+	require.False(t, cs.Review())
+	cs = append(cs, &Change{Kind: Removed, Object: ObjectField, Type: "User", Name: "name", DBBreaking: true})
+	require.False(t, cs.APIBreaking())
+	require.True(t, cs.DBBreaking())
+	buf, err := json.Marshal(cs[1])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind":"removed","object":"field","type":"User","name":"name","desc":"","api_breaking":false,"db_breaking":true,"review":false}`, string(buf))
+}
+
+func TestReadSnapshot(t *testing.T) {
+	buf, err := json.Marshal(gen.Snapshot{
+		Schemas: []*load.Schema{{Name: "User"}},
+	})
+	require.NoError(t, err)
+	content := fmt.Sprintf("package internal\n\nconst Schema = %s\n", strconv.Quote(string(buf)))
+	schemas, err := ReadSnapshot("ent/internal/schema.go", []byte(content))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	require.Equal(t, "User", schemas[0].Name)
+	_, err = ReadSnapshot("ent/internal/schema.go", []byte("package internal\n"))
+	require.Error(t, err)
+}
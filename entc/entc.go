@@ -27,7 +27,12 @@ import (
 // LoadGraph loads the schema package from the given schema path,
 // and constructs a *gen.Graph.
 func LoadGraph(schemaPath string, cfg *gen.Config) (*gen.Graph, error) {
-	spec, err := (&load.Config{Path: schemaPath, BuildFlags: cfg.BuildFlags}).Load()
+	return loadGraph(&load.Config{Path: schemaPath, BuildFlags: cfg.BuildFlags}, cfg)
+}
+
+// loadGraph loads the schema package using the given loader config, and returns its graph.
+func loadGraph(lcfg *load.Config, cfg *gen.Config) (*gen.Graph, error) {
+	spec, err := lcfg.Load()
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +42,7 @@ func LoadGraph(schemaPath string, cfg *gen.Config) (*gen.Graph, error) {
 		// before the schema package (`<project>/ent/schema`).
 		cfg.Package = path.Dir(spec.PkgPath)
 	}
-	if err := defaultTarget(schemaPath, cfg); err != nil {
+	if err := defaultTarget(lcfg.Path, cfg); err != nil {
 		return nil, err
 	}
 	return gen.NewGraph(cfg, spec.Schemas...)
@@ -51,6 +51,97 @@ var (
 		Description: "NamedEdges provides an API for eager-loading edges with dynamic names",
 	}
 
+	// FeatureNamedHooks provides a feature-flag for registering mutation hooks with
+	// a name and a priority, and for skipping them per call using hook.Skip.
+	FeatureNamedHooks = Feature{
+		Name:        "namedhooks",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "NamedHooks provides an API for registering, listing, removing and skipping hooks by name",
+	}
+
+	// FeatureBulkHooks provides a feature-flag for registering hooks that are executed
+	// once per bulk operation, and see all its mutations or the resolved IDs.
+	FeatureBulkHooks = Feature{
+		Name:        "bulkhooks",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "BulkHooks provides an API for registering hooks that are executed once for CreateBulk and predicate-based Update/Delete",
+	}
+
+	// FeatureLookups provides a feature-flag for generating client methods for getting
+	// entities by their unique fields and indexes, and for getting entities by ids in batch.
+	FeatureLookups = Feature{
+		Name:        "lookups",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Lookups provides an API for getting entities by their unique fields and indexes (e.g. GetByEmail), one by one or in batch",
+	}
+
+	// FeatureClone provides a feature-flag for generating builders for deep cloning entities,
+	// including the subgraph of edges that were selected for cloning.
+	FeatureClone = Feature{
+		Name:        "clone",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Clone provides an API for copying entities and their selected edges within a transaction",
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "clone.go"))
+		},
+	}
+
+	// FeatureClientAPI provides a feature-flag for generating interfaces for the client API,
+	// and a fake client that is backed by configurable expectations for testing.
+	FeatureClientAPI = Feature{
+		Name:        "clientapi",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "ClientAPI generates interfaces for the client, query and mutation builders, and a fake client for testing (entfake)",
+		cleanup: func(c *Config) error {
+			if err := os.RemoveAll(filepath.Join(c.Target, "entfake")); err != nil {
+				return err
+			}
+			return os.RemoveAll(filepath.Join(c.Target, "clientapi.go"))
+		},
+	}
+
+	// FeatureReadOnly provides a feature-flag for generating a read-only client that exposes
+	// only the query builders of the client, and rejects writes using a read-only driver.
+	FeatureReadOnly = Feature{
+		Name:        "readonly",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "ReadOnly generates a read-only client that exposes only query builders, traversals and Get methods",
+	}
+
+	// FeatureTypedIDs provides a feature-flag for generating a distinct ID type per entity (e.g. UserID),
+	// to prevent passing the ID of one type where the ID of another type is expected.
+	FeatureTypedIDs = Feature{
+		Name:        "typedids",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "TypedIDs generates a named ID type per entity (e.g. `type UserID int`) that is used by all generated APIs",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "entid",
+				Format: "entid/entid.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "entid"))
+		},
+	}
+
+	// FeatureGenerics provides a feature-flag for generating the execution methods of the builders
+	// and the generic helpers of the mutations as wrappers over the functions of the entcore package.
+	// The setters, the storage specs and the mutation bodies are still generated per entity.
+	FeatureGenerics = Feature{
+		Name:        "generics",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Generics generates the execution methods of the builders and the ID and getter methods of the mutations as wrappers over the entgo.io/ent/entcore package",
+	}
+
 	// FeatureBidiEdgeRefs provides a feature-flag for sql dialect to set two-way
 	// references when loading (unique) edges. Note, users that use the standard
 	// encoding/json.MarshalJSON should detach the circular references before marshaling.
@@ -97,6 +188,15 @@ var (
 		},
 	}
 
+	// FeatureSchemaResolver allows users to route the operations of the client to the database
+	// schema that is resolved from their context. This is useful for schema-per-tenant setups.
+	FeatureSchemaResolver = Feature{
+		Name:        "sql/schemaresolver",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows routing client operations to the database schema resolved from their context (e.g. schema-per-tenant)",
+	}
+
 	// featureMultiSchema indicates that ent/schema is annotated with multiple schemas.
 	// This feature-flag is enabled by default by the storage driver and exists to pass
 	// this info to the templates.
@@ -113,6 +213,14 @@ var (
 		Description: "Allows users to use row-level locking in SQL using the 'FOR {UPDATE|SHARE}' clauses",
 	}
 
+	// FeatureAdvisoryLock provides a feature-flag for acquiring advisory locks using the generated client.
+	FeatureAdvisoryLock = Feature{
+		Name:        "sql/advisorylock",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to acquire session-level and transaction-level advisory locks using the generated client",
+	}
+
 	// FeatureModifier provides a feature-flag for adding query modifiers.
 	FeatureModifier = Feature{
 		Name:        "sql/modifier",
@@ -137,6 +245,32 @@ var (
 		Description: "Allows users to configure the `ON CONFLICT`/`ON DUPLICATE KEY` clause for `INSERT` statements",
 	}
 
+	// FeatureReturning provides a feature-flag for returning the entities affected by bulk updates and deletions.
+	FeatureReturning = Feature{
+		Name:        "sql/returning",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to get the entities affected by bulk updates and deletions using the `RETURNING` clause",
+	}
+
+	// FeatureClaim provides a feature-flag for claiming entities using the SELECT ... FOR UPDATE SKIP LOCKED
+	// clause. For example, for taking the jobs of a work-queue.
+	FeatureClaim = Feature{
+		Name:        "sql/claim",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to atomically claim (select and update) entities that are not locked by other transactions using `SKIP LOCKED`",
+	}
+
+	// FeatureInet provides a feature-flag for filtering network address fields using the PostgreSQL
+	// network operators. The generated predicates are not supported by other dialects.
+	FeatureInet = Feature{
+		Name:        "sql/inet",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Allows users to filter IP and prefix fields using the PostgreSQL network operators (e.g. `<<=` and `&&`)",
+	}
+
 	FeatureVersionedMigration = Feature{
 		Name:        "sql/versioned-migration",
 		Stage:       Experimental,
@@ -165,13 +299,26 @@ var (
 		FeatureIntercept,
 		FeatureEntQL,
 		FeatureNamedEdges,
+		FeatureNamedHooks,
+		FeatureBulkHooks,
+		FeatureLookups,
+		FeatureClone,
+		FeatureClientAPI,
+		FeatureReadOnly,
+		FeatureTypedIDs,
+		FeatureGenerics,
 		FeatureBidiEdgeRefs,
 		FeatureSnapshot,
 		FeatureSchemaConfig,
+		FeatureSchemaResolver,
 		FeatureLock,
+		FeatureAdvisoryLock,
 		FeatureModifier,
 		FeatureExecQuery,
 		FeatureUpsert,
+		FeatureReturning,
+		FeatureClaim,
+		FeatureInet,
 		FeatureVersionedMigration,
 		FeatureGlobalID,
 	}
@@ -132,6 +132,15 @@ func fieldOps(f *Field) (ops []Op) {
 		}
 	case t == field.TypeEnum || f.IsEdgeField():
 		ops = enumOps
+	case t == field.TypeMAC:
+		// MAC addresses are stored in their canonical form (fixed-width
+		// hex digits), and therefore, are ordered by their bytes.
+		ops = numericOps
+	case t == field.TypeIP || t == field.TypePrefix:
+		// The textual representation of addresses and prefixes is not ordered
+		// by their bytes outside PostgreSQL. Containment checks are generated
+		// separately by the "sql/inet" feature.
+		ops = enumOps
 	default:
 		ops = numericOps
 	}
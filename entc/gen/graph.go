@@ -13,7 +13,9 @@ import (
 	"go/token"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"runtime/debug"
 	"strconv"
@@ -108,6 +110,9 @@ type (
 		nodes map[string]*Type
 		// Schemas holds the raw interfaces for the loaded schemas.
 		Schemas []*load.Schema
+		// only holds the nodes to execute the type templates
+		// for. A nil map means all nodes in the graph.
+		only map[string]struct{}
 	}
 
 	// Generator is the interface that wraps the Generate method.
@@ -169,12 +174,17 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	for _, t := range g.Nodes {
 		check(g.resolve(t), "resolve %q relations", t.Name)
 	}
+	check(g.viewEdges(), "resolving view edges")
 	for _, t := range g.Nodes {
 		check(t.setupFKs(), "set %q foreign-keys", t.Name)
 	}
+	if enabled, _ := g.Config.FeatureEnabled(FeatureTypedIDs.Name); enabled {
+		g.typedIDs()
+	}
 	for i := range schemas {
 		g.addIndexes(schemas[i])
 	}
+	check(g.claimFields(), "resolving claim fields")
 	check(g.edgeSchemas(), "resolving edges")
 	aliases(g)
 	g.defaults()
@@ -216,7 +226,11 @@ func (g *Graph) defaults() {
 	}
 	idTypes := make([]*field.TypeInfo, 0, len(g.Nodes))
 	for _, n := range g.Nodes {
-		if n.HasOneFieldID() {
+		switch {
+		case !n.HasOneFieldID():
+		case n.ID.idBase != nil:
+			idTypes = append(idTypes, n.ID.idBase)
+		default:
 			idTypes = append(idTypes, n.ID.Type)
 		}
 	}
@@ -238,6 +252,78 @@ func (g *Graph) Gen() error {
 	return gen.Generate(g)
 }
 
+// GenNodes generates the artifacts for the graph, but executes the type
+// templates only for the given nodes. Graph templates are always executed,
+// because they depend on all nodes in the graph. It is used by the watch
+// mode to regenerate only the nodes affected by a schema change.
+func (g *Graph) GenNodes(names ...string) error {
+	g.only = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		g.only[name] = struct{}{}
+	}
+	defer func() { g.only = nil }()
+	return g.Gen()
+}
+
+// Affected returns the names of the nodes in the graph whose generated assets
+// may be affected by the schema changes between prev and g. That is, nodes
+// whose schema was changed, and nodes that are connected to them by an edge.
+// If the set of nodes was changed, all is true and a full generation is required.
+func (g *Graph) Affected(prev *Graph) (names []string, all bool) {
+	if prev == nil || len(prev.Schemas) != len(g.Schemas) {
+		return nil, true
+	}
+	changed := make(map[string]bool, len(g.Schemas))
+	for _, s := range g.Schemas {
+		ps, ok := prev.schema(s.Name)
+		if !ok {
+			return nil, true
+		}
+		b1, err1 := json.Marshal(s)
+		b2, err2 := json.Marshal(ps)
+		if err1 != nil || err2 != nil || !bytes.Equal(b1, b2) {
+			changed[s.Name] = true
+		}
+	}
+	affected := make(map[string]bool, len(changed))
+	// Both graphs are visited to include nodes
+	// that their edges were added or removed.
+	for _, gr := range []*Graph{prev, g} {
+		for _, n := range gr.Nodes {
+			for _, e := range n.Edges {
+				deps := []*Type{e.Type}
+				if e.Through != nil {
+					deps = append(deps, e.Through)
+				}
+				for _, d := range deps {
+					switch {
+					case changed[n.Name]:
+						affected[d.Name] = true
+					case changed[d.Name]:
+						affected[n.Name] = true
+					}
+				}
+			}
+		}
+	}
+	for _, n := range g.Nodes {
+		if changed[n.Name] || affected[n.Name] {
+			names = append(names, n.Name)
+		}
+	}
+	return names, false
+}
+
+// schema returns the loaded schema with the given name.
+func (g *Graph) schema(name string) (*load.Schema, bool) {
+	for _, s := range g.Schemas {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
 // generate is the default Generator implementation.
 func generate(g *Graph) error {
 	var (
@@ -247,6 +333,9 @@ func generate(g *Graph) error {
 	templates, external = g.templates()
 	for _, n := range g.Nodes {
 		assets.addDir(filepath.Join(g.Config.Target, n.PackageDir()))
+		if _, ok := g.only[n.Name]; g.only != nil && !ok {
+			continue
+		}
 		for _, tmpl := range Templates {
 			if tmpl.Cond != nil && !tmpl.Cond(n) {
 				continue
@@ -490,6 +579,127 @@ func (g *Graph) resolve(t *Type) error {
 	return nil
 }
 
+// typedIDKinds maps the ID types that can be replaced by typed IDs to their kinds.
+var typedIDKinds = map[field.Type]reflect.Kind{
+	field.TypeInt:    reflect.Int,
+	field.TypeInt8:   reflect.Int8,
+	field.TypeInt16:  reflect.Int16,
+	field.TypeInt32:  reflect.Int32,
+	field.TypeInt64:  reflect.Int64,
+	field.TypeUint:   reflect.Uint,
+	field.TypeUint8:  reflect.Uint8,
+	field.TypeUint16: reflect.Uint16,
+	field.TypeUint32: reflect.Uint32,
+	field.TypeUint64: reflect.Uint64,
+	field.TypeString: reflect.String,
+}
+
+// typedIDs replaces the ID types of the nodes with named types that are defined in
+// the entid package (e.g. entid.UserID), and updates the types of the foreign-keys
+// and the edge-fields that reference them. IDs that were defined with a custom GoType
+// (except UUIDs) are kept as-is.
+func (g *Graph) typedIDs() {
+	pkg := path.Join(g.Config.Package, "entid")
+	for _, n := range g.Nodes {
+		if !n.HasOneFieldID() {
+			continue
+		}
+		var (
+			base     = n.ID.Type
+			name     = n.Name + "ID"
+			kind, ok = typedIDKinds[base.Type]
+			methods  map[string]struct{ In, Out []*field.RType }
+		)
+		switch {
+		case base.Type == field.TypeUUID && base.ValueScanner():
+			kind, methods = base.RType.Kind, base.RType.Methods
+		case !ok || base.RType != nil:
+			continue
+		}
+		n.ID.idBase = base
+		n.ID.Type = &field.TypeInfo{
+			Type:     base.Type,
+			Ident:    "entid." + name,
+			PkgPath:  pkg,
+			PkgName:  "entid",
+			Nillable: base.Nillable,
+			RType: &field.RType{
+				Name:    name,
+				Ident:   "entid." + name,
+				Kind:    kind,
+				PkgPath: pkg,
+				Methods: methods,
+			},
+		}
+	}
+	for _, t := range g.Nodes {
+		for _, fk := range t.ForeignKeys {
+			ref := fk.Edge.Owner
+			if fk.Edge.OwnFK() {
+				ref = fk.Edge.Type
+			}
+			// Skip foreign-keys that are defined as the ID field of their type (Issue 1288).
+			if ref.ID == nil || ref.ID.idBase == nil || fk.Field == t.ID {
+				continue
+			}
+			fk.Field.Type = ref.ID.Type
+		}
+	}
+}
+
+// claimFields validates the lease and the owner fields that were defined for
+// the types using the entsql.Claim annotation.
+func (g *Graph) claimFields() error {
+	for _, n := range g.Nodes {
+		ant := n.EntSQL()
+		if ant == nil || ant.ClaimLease == "" && ant.ClaimOwner == "" {
+			continue
+		}
+		if n.IsView() {
+			return fmt.Errorf("entities of view %s cannot be claimed, because views do not have identifiers", n.Name)
+		}
+		if ant.ClaimLease != "" {
+			switch f := n.ClaimLease(); {
+			case f == nil:
+				return fmt.Errorf("claim lease field %q was not found in schema %s", ant.ClaimLease, n.Name)
+			case !f.IsTime():
+				return fmt.Errorf("claim lease field %s.%s must be a time field", n.Name, f.Name)
+			}
+		}
+		if ant.ClaimOwner != "" {
+			switch f := n.ClaimOwner(); {
+			case f == nil:
+				return fmt.Errorf("claim owner field %q was not found in schema %s", ant.ClaimOwner, n.Name)
+			case !f.IsString():
+				return fmt.Errorf("claim owner field %s.%s must be a string field", n.Name, f.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// viewEdges ensures that edges from and to views are valid. Since views do not have
+// identifiers, their relations must be stored in the views as edge-fields. Hence, two
+// views cannot be connected, and only the view side of an edge can hold its foreign-key.
+func (g *Graph) viewEdges() error {
+	for _, n := range g.Nodes {
+		for _, e := range n.Edges {
+			switch {
+			case !n.IsView() && !e.Type.IsView():
+			case n.IsView() && e.Type.IsView():
+				return fmt.Errorf("edge %s.%s cannot connect view %s to view %s", n.Name, e.Name, n.Name, e.Type.Name)
+			case e.M2M():
+				return fmt.Errorf("edge %s.%s cannot be a M2M relation, because views do not have identifiers", n.Name, e.Name)
+			case n.IsView() && (!e.OwnFK() || e.def.Field == ""):
+				return fmt.Errorf("edge %s.%s must be defined with an edge-field, because view %s holds its foreign-key", n.Name, e.Name, n.Name)
+			case e.Type.IsView() && (e.Ref == nil || e.Ref.def.Field == ""):
+				return fmt.Errorf("edge %s.%s must have a back-reference in view %s that is defined with an edge-field", n.Name, e.Name, e.Type.Name)
+			}
+		}
+	}
+	return nil
+}
+
 // edgeSchemas visits all edges in the graph and detects which schemas are used as "edge schemas".
 // Note, edge schemas cannot be used by more than one association (edge.To), must define two required
 // edges (+ edge-fields) to the types that go through them, and allow adding additional fields with
@@ -633,7 +843,8 @@ func (g *Graph) edgeSchemas() error {
 	return nil
 }
 
-// Tables returns the schema definitions of SQL tables for the graph.
+// Tables returns the schema definitions of SQL tables for the graph,
+// including the materialized views.
 func (g *Graph) Tables() (all []*schema.Table, err error) {
 	tables := make(map[string]*schema.Table)
 	for _, n := range g.MutableNodes() {
@@ -671,7 +882,9 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 	for _, n := range g.Nodes {
 		// Foreign key and its reference, or a join table.
 		for _, e := range n.Edges {
-			if e.IsInverse() {
+			// Relations from or to views are backed by view columns
+			// and are not enforced using foreign-key constraints.
+			if e.IsInverse() || n.IsView() || e.Type.IsView() {
 				continue
 			}
 			switch e.Rel.Type {
@@ -771,7 +984,7 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 		}
 	}
 	// Append indexes to tables after all columns were added (including relation columns).
-	for _, n := range g.Nodes {
+	for _, n := range g.MutableNodes() {
 		table := tables[n.Table()]
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
@@ -783,34 +996,57 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 	if err := ensureUniqueFKs(tables); err != nil {
 		return nil, err
 	}
+	// Materialized views hold data, and are managed by the migration
+	// as tables on dialects that do not support materialized views.
+	for _, n := range g.Nodes {
+		if v := n.view(); v != nil && n.IsMaterializedView() {
+			all = append(all, v)
+		}
+	}
 	return
 }
 
-// Views returns all schema views
+// Views returns all schema views, except materialized views.
 func (g *Graph) Views() (views []*schema.Table, err error) {
 	for _, n := range g.Nodes {
-		if !n.IsView() {
-			continue
+		if v := n.view(); v != nil && !n.IsMaterializedView() {
+			views = append(views, v)
 		}
-		view := schema.NewView(n.Table()).
-			SetComment(n.sqlComment()).
-			SetPos(n.Pos())
-		switch ant := n.EntSQL(); {
-		case ant == nil:
-		case ant.Skip:
+	}
+	return
+}
+
+// view returns the schema view of the type, or nil if
+// the type is not a view or it is skipped by the migration.
+func (t *Type) view() *schema.Table {
+	if !t.IsView() {
+		return nil
+	}
+	view := schema.NewView(t.Table()).
+		SetComment(t.sqlComment()).
+		SetPos(t.Pos())
+	switch ant := t.EntSQL(); {
+	case ant == nil:
+	case ant.Skip:
+		return nil
+	default:
+		view.SetAnnotation(ant).SetSchema(ant.Schema)
+	}
+	for _, f := range t.Fields {
+		if a := f.EntSQL(); a != nil && a.Skip {
 			continue
-		default:
-			view.SetAnnotation(ant).SetSchema(ant.Schema)
 		}
-		for _, f := range n.Fields {
-			if a := f.EntSQL(); a != nil && a.Skip {
-				continue
-			}
-			view.AddColumn(f.Column())
+		view.AddColumn(f.Column())
+	}
+	// Indexes are supported only on materialized views.
+	if t.IsMaterializedView() {
+		for _, idx := range t.Indexes {
+			view.AddIndex(idx.Name, idx.Unique, idx.Columns)
+			index, _ := view.Index(idx.Name)
+			index.Annotation = sqlIndexAnnotate(idx.Annotations)
 		}
-		views = append(views, view)
 	}
-	return
+	return view
 }
 
 // mayAddColumn adds the given column if it does not already exist in the table.
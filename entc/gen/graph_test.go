@@ -259,6 +259,66 @@ func TestNewGraphThroughDuplicates(t *testing.T) {
 	require.EqualError(t, err, `entc/gen: resolving edges: edge User.groups defined with Through("group_edges", T1.Type), but schema User already has an edge named group_edges`)
 }
 
+func TestNewGraphViewEdges(t *testing.T) {
+	var (
+		user = &load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "stats", Type: "UserStats", Unique: true},
+			},
+		}
+		stats = &load.Schema{
+			View: true,
+			Name: "UserStats",
+			Fields: []*load.Field{
+				{Name: "user_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+			Edges: []*load.Edge{
+				{Name: "user", Type: "User", RefName: "stats", Inverse: true, Unique: true, Required: true, Field: "user_id"},
+			},
+		}
+	)
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, stats)
+	require.NoError(t, err)
+	e := g.Nodes[1].Edges[0]
+	require.Equal(t, O2O, e.Rel.Type)
+	require.Equal(t, "user_stats", e.Rel.Table)
+	require.Equal(t, "user_id", e.Rel.Column())
+	require.Equal(t, "user_id", e.Field().Name)
+	require.Empty(t, g.Nodes[0].EdgesWithID(), "edges to views cannot be mutated")
+	ts, err := g.Tables()
+	require.NoError(t, err)
+	require.Len(t, ts, 1)
+	require.Empty(t, ts[0].ForeignKeys)
+	vs, err := g.Views()
+	require.NoError(t, err)
+	require.Len(t, vs, 1)
+	require.Len(t, vs[0].Columns, 1)
+	require.Empty(t, vs[0].ForeignKeys)
+
+	// Edge without a field.
+	stats.Edges[0].Field = ""
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, stats, user)
+	require.EqualError(t, err, `entc/gen: resolving view edges: edge UserStats.user must be defined with an edge-field, because view UserStats holds its foreign-key`)
+
+	// Edge without a back-reference.
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, &load.Schema{View: true, Name: "UserStats"})
+	require.EqualError(t, err, `entc/gen: resolving view edges: edge User.stats must have a back-reference in view UserStats that is defined with an edge-field`)
+
+	// Edge between views.
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		View: true,
+		Name: "V",
+		Fields: []*load.Field{
+			{Name: "parent_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Edges: []*load.Edge{
+			{Name: "parent", Type: "V", Unique: true, Field: "parent_id"},
+		},
+	})
+	require.EqualError(t, err, `entc/gen: resolving view edges: edge V.parent cannot connect view V to view V`)
+}
+
 func TestRelation(t *testing.T) {
 	require := require.New(t)
 	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, T1)
@@ -445,6 +505,115 @@ func TestPosition(t *testing.T) {
 	require.Equal(t, vs[0].Pos, "pet_view.go:10")
 }
 
+func TestMaterializedView(t *testing.T) {
+	var (
+		pet = &load.Schema{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+		}
+		petStats = &load.Schema{
+			View: true,
+			Name: "PetStats",
+			Fields: []*load.Field{
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+				{Name: "pets", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+			Indexes: []*load.Index{
+				{Unique: true, Fields: []string{"owner_id"}},
+			},
+			Annotations: map[string]any{
+				entsql.Annotation{}.Name(): map[string]any{"view_as": "SELECT owner_id, COUNT(*) AS pets FROM pets GROUP BY owner_id", "materialized": true},
+			},
+		}
+	)
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, pet, petStats)
+	require.NoError(t, err)
+	require.False(t, g.Nodes[0].IsMaterializedView())
+	require.True(t, g.Nodes[1].IsMaterializedView())
+	ts, err := g.Tables()
+	require.NoError(t, err)
+	require.Len(t, ts, 2)
+	require.True(t, ts[1].View)
+	require.True(t, ts[1].Annotation.Materialized)
+	require.Len(t, ts[1].Indexes, 1)
+	require.True(t, ts[1].Indexes[0].Unique)
+	require.Equal(t, "owner_id", ts[1].Indexes[0].Columns[0].Name)
+	vs, err := g.Views()
+	require.NoError(t, err)
+	require.Empty(t, vs)
+}
+
+func TestClaimFields(t *testing.T) {
+	job := &load.Schema{
+		Name: "Job",
+		Fields: []*load.Field{
+			{Name: "leased_until", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true, Nillable: true},
+			{Name: "worker", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+		},
+		Annotations: map[string]any{
+			entsql.Annotation{}.Name(): map[string]any{"claim_lease": "leased_until", "claim_owner": "worker"},
+		},
+	}
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, job)
+	require.NoError(t, err)
+	require.Equal(t, "leased_until", g.Nodes[0].ClaimLease().Name)
+	require.Equal(t, "worker", g.Nodes[0].ClaimOwner().Name)
+
+	job.Annotations[entsql.Annotation{}.Name()] = map[string]any{"claim_lease": "worker"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, job)
+	require.EqualError(t, err, "entc/gen: resolving claim fields: claim lease field Job.worker must be a time field")
+
+	job.Annotations[entsql.Annotation{}.Name()] = map[string]any{"claim_owner": "owner"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, job)
+	require.EqualError(t, err, `entc/gen: resolving claim fields: claim owner field "owner" was not found in schema Job`)
+}
+
+func TestTypedIDs(t *testing.T) {
+	var (
+		user = &load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "groups", Type: "Group"},
+			},
+		}
+		pet = &load.Schema{
+			Name: "Pet",
+			Fields: []*load.Field{
+				{Name: "id", Info: &field.TypeInfo{Type: field.TypeString}},
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true, Field: "owner_id"},
+			},
+		}
+		group = &load.Schema{
+			Name: "Group",
+			Fields: []*load.Field{
+				{Name: "id", Info: &field.TypeInfo{Type: field.TypeString, Ident: "GroupID", PkgPath: "example.com/types", RType: &field.RType{Kind: reflect.String}}},
+			},
+		}
+	)
+	g, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Features: []Feature{FeatureTypedIDs}}, user, pet, group)
+	require.NoError(t, err)
+	require.Equal(t, "entid.UserID", g.Nodes[0].ID.Type.String())
+	require.Equal(t, field.TypeInt, g.Nodes[0].ID.IDBaseType().Type)
+	require.Equal(t, "entid.PetID", g.Nodes[1].ID.Type.String())
+	require.Equal(t, reflect.String, g.Nodes[1].ID.Type.RType.Kind)
+	require.Equal(t, "entc/gen/entid", g.Nodes[1].ID.Type.PkgPath)
+	// Edge-fields and foreign-keys use the typed IDs of the referenced types.
+	owner, ok := g.Nodes[1].FieldBy(func(f *Field) bool { return f.Name == "owner_id" })
+	require.True(t, ok)
+	require.Equal(t, "entid.UserID", owner.Type.String())
+	require.Equal(t, "entid.UserID", g.Nodes[1].Edges[0].Type.ID.Type.String())
+	// IDs with custom Go types are not replaced.
+	require.Equal(t, "GroupID", g.Nodes[2].ID.Type.String())
+	require.Nil(t, g.Nodes[2].ID.IDBaseType())
+	require.Equal(t, field.TypeInt, g.IDType.Type)
+}
+
 func TestMultiSchemaAnnotation(t *testing.T) {
 	antFn := func(s string) map[string]any {
 		return map[string]any{entsql.Annotation{}.Name(): map[string]string{"schema": s}}
@@ -571,6 +740,14 @@ func TestGraph_Gen(t *testing.T) {
 	require.NoError(err)
 	_, err = os.Stat(filepath.Join(target, "internal", "schemaconfig.go"))
 	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "clone.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "clientapi.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "entfake", "entfake.go"))
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(target, "entid", "entid.go"))
+	require.NoError(err)
 	c, err := os.ReadFile(filepath.Join(target, "internal", "globalid.go"))
 	require.NoError(err)
 	require.Contains(string(c), fmt.Sprintf(`"{\"t1s\":0,\"t2s\":%d,\"t3s\":%d}"`, 1<<32, 2<<32))
@@ -584,6 +761,12 @@ func TestGraph_Gen(t *testing.T) {
 	require.True(os.IsNotExist(err))
 	_, err = os.Stat(filepath.Join(target, "internal", "globalid.go"))
 	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "clone.go"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "entfake"))
+	require.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(target, "entid"))
+	require.True(os.IsNotExist(err))
 	// Rerun codegen without any feature-flags.
 	graph.Features = nil
 	require.NoError(graph.Gen())
@@ -611,6 +794,66 @@ func TestGraph_Gen(t *testing.T) {
 	}
 }
 
+func TestGraph_Affected(t *testing.T) {
+	cfg := &Config{Package: "entc/gen", Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}}
+	schemas := func(userField string) []*load.Schema {
+		return []*load.Schema{
+			{
+				Name:   "User",
+				Fields: []*load.Field{{Name: userField, Info: &field.TypeInfo{Type: field.TypeString}}},
+				Edges:  []*load.Edge{{Name: "pets", Type: "Pet"}},
+			},
+			{Name: "Pet"},
+			{Name: "Group", Fields: []*load.Field{{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}}}},
+		}
+	}
+	prev, err := NewGraph(cfg, schemas("name")...)
+	require.NoError(t, err)
+	names, all := prev.Affected(nil)
+	require.True(t, all)
+	require.Nil(t, names)
+
+	g, err := NewGraph(cfg, schemas("name")...)
+	require.NoError(t, err)
+	names, all = g.Affected(prev)
+	require.False(t, all)
+	require.Empty(t, names)
+
+	// Nodes that are connected to the changed schema are affected as well.
+	g, err = NewGraph(cfg, schemas("nickname")...)
+	require.NoError(t, err)
+	names, all = g.Affected(prev)
+	require.False(t, all)
+	require.Equal(t, []string{"User", "Pet"}, names)
+
+	// Removing an edge affects the nodes it was connected to.
+	s := schemas("name")
+	s[0].Edges = nil
+	g, err = NewGraph(cfg, s...)
+	require.NoError(t, err)
+	names, all = g.Affected(prev)
+	require.False(t, all)
+	require.Equal(t, []string{"User", "Pet"}, names)
+
+	g, err = NewGraph(cfg, schemas("name")[:2]...)
+	require.NoError(t, err)
+	_, all = g.Affected(prev)
+	require.True(t, all)
+
+	// Only the templates of the given nodes are executed.
+	target := filepath.Join(t.TempDir(), "ent")
+	prev.Target = target
+	require.NoError(t, prev.Gen())
+	require.NoError(t, os.Remove(filepath.Join(target, "user_query.go")))
+	require.NoError(t, os.Remove(filepath.Join(target, "group_query.go")))
+	require.NoError(t, os.Remove(filepath.Join(target, "client.go")))
+	require.NoError(t, prev.GenNodes("Group"))
+	for name, exists := range map[string]bool{"user_query.go": false, "group_query.go": true, "client.go": true, "pet": true} {
+		_, err := os.Stat(filepath.Join(target, name))
+		require.Equal(t, exists, err == nil, name)
+	}
+}
+
 func ensureStructTag(name string) Hook {
 	return func(next Generator) Generator {
 		return GenerateFunc(func(g *Graph) error {
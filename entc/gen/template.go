@@ -154,6 +154,34 @@ var (
 				return !g.featureEnabled(FeatureEntQL)
 			},
 		},
+		{
+			Name:   "clone",
+			Format: "clone.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureClone)
+			},
+		},
+		{
+			Name:   "clientapi",
+			Format: "clientapi.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureClientAPI)
+			},
+		},
+		{
+			Name:   "entfake",
+			Format: "entfake/entfake.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureClientAPI)
+			},
+		},
+		{
+			Name:   "entfake/match",
+			Format: "entfake/match.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureClientAPI) || g.Storage.Name != "sql"
+			},
+		},
 		{
 			Name:   "runtime/ent",
 			Format: "runtime.go",
@@ -196,6 +224,7 @@ var (
 		"dialect/sql/query/from/*",
 		"dialect/sql/query/path/*",
 		"dialect/sql/query/*/*/*",
+		"hook/additional/*",
 		"import/additional/*",
 		"model/additional/*",
 		"model/comment/additional/*",
@@ -102,6 +102,8 @@ type (
 		Annotations Annotations
 		// referenced foreign-key.
 		fk *ForeignKey
+		// idBase holds the type that was replaced by a typed ID (see FeatureTypedIDs).
+		idBase *field.TypeInfo
 	}
 
 	// Edge of a graph between two types.
@@ -177,6 +179,17 @@ type (
 		Annotations Annotations
 	}
 
+	// Lookup holds the fields of a unique field or a unique index that identify a single node
+	// of a type. It's exported only because it's used by the codegen templates and should not
+	// be used beside that.
+	Lookup struct {
+		// Fields of the lookup, ordered as defined in the index.
+		Fields []*Field
+		// pkg is the package name of the type, used for
+		// avoiding collisions with the parameter names.
+		pkg string
+	}
+
 	// ForeignKey holds the information for foreign-key columns of types.
 	// It's exported only because it's used by the codegen templates and
 	// should not be used beside that.
@@ -280,6 +293,40 @@ func (t Type) IsView() bool {
 	return t.schema != nil && t.schema.View
 }
 
+// IsMaterializedView indicates if the type (schema) is a materialized view.
+func (t Type) IsMaterializedView() bool {
+	ant := t.EntSQL()
+	return t.IsView() && ant != nil && ant.Materialized
+}
+
+// ClaimLease returns the field that holds the lease time of the entities claimed by the
+// Claim method of the query builder, or nil if it was not defined for the type.
+func (t Type) ClaimLease() *Field {
+	if ant := t.EntSQL(); ant != nil && ant.ClaimLease != "" {
+		return t.fieldNamed(ant.ClaimLease)
+	}
+	return nil
+}
+
+// ClaimOwner returns the field that holds the owner of the entities claimed by the
+// Claim method of the query builder, or nil if it was not defined for the type.
+func (t Type) ClaimOwner() *Field {
+	if ant := t.EntSQL(); ant != nil && ant.ClaimOwner != "" {
+		return t.fieldNamed(ant.ClaimOwner)
+	}
+	return nil
+}
+
+// fieldNamed returns the (non-id) field with the given name, or nil if it does not exist.
+func (t Type) fieldNamed(name string) *Field {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
 // IsEdgeSchema indicates if the type (schema) is used as an edge-schema.
 // i.e. is being used by an edge (or its inverse) with edge.Through modifier.
 func (t Type) IsEdgeSchema() bool {
@@ -296,6 +343,59 @@ func (t Type) HasOneFieldID() bool {
 	return !t.HasCompositeID() && t.ID != nil
 }
 
+// Lookups returns the unique fields and the unique indexes of the type that can be used for
+// looking up a single node. Indexes that contain columns of edges without fields, or fields
+// that cannot be compared using the equality predicate, are skipped.
+func (t Type) Lookups() []*Lookup {
+	var (
+		lookups []*Lookup
+		seen    = make(map[string]bool)
+	)
+	add := func(fields ...*Field) {
+		l := &Lookup{Fields: fields, pkg: t.Package()}
+		for _, f := range fields {
+			if !f.hasOp(EQ) {
+				return
+			}
+		}
+		if name := l.Name(); !seen[name] {
+			seen[name] = true
+			lookups = append(lookups, l)
+		}
+	}
+	for _, f := range t.Fields {
+		if f.Unique {
+			add(f)
+		}
+	}
+	columns := make(map[string]*Field, len(t.Fields))
+	for _, f := range t.Fields {
+		columns[f.StorageKey()] = f
+	}
+idx:
+	for _, idx := range t.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		fields := make([]*Field, len(idx.Columns))
+		for i, c := range idx.Columns {
+			f, ok := columns[c]
+			if !ok {
+				continue idx
+			}
+			fields[i] = f
+		}
+		add(fields...)
+	}
+	return lookups
+}
+
+// HasMapKeyID indicates if the type has an ID with one field,
+// and its Go type can be used as a map key.
+func (t Type) HasMapKeyID() bool {
+	return t.HasOneFieldID() && t.ID.mapKey()
+}
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string {
 	return snake(t.Name)
@@ -453,7 +553,7 @@ func (t Type) FKEdges() (edges []*Edge) {
 // These types of edges can be created, updated and deleted by their identifiers.
 func (t Type) EdgesWithID() (edges []*Edge) {
 	for _, e := range t.Edges {
-		if !e.Type.HasCompositeID() {
+		if e.Type.HasOneFieldID() {
 			edges = append(edges, e)
 		}
 	}
@@ -899,6 +999,12 @@ func (t Type) MutationName() string {
 	return pascal(t.Name) + "Mutation"
 }
 
+// CoreName returns the variable name of the generic core of the builders of this type.
+// Used by the generated code when the "generics" feature is enabled.
+func (t Type) CoreName() string {
+	return camel(snake(t.Name)) + "Core"
+}
+
 // GroupReceiver returns the receiver name of the group-by builder for this type.
 func (t Type) GroupReceiver() string {
 	return "_g"
@@ -1335,6 +1441,24 @@ func (f Field) IsBytes() bool { return f.Type != nil && f.Type.Type == field.Typ
 // IsTime returns true if the field is a timestamp field.
 func (f Field) IsTime() bool { return f.Type != nil && f.Type.Type == field.TypeTime }
 
+// IsDate returns true if the field is a date field.
+func (f Field) IsDate() bool { return f.Type != nil && f.Type.Type == field.TypeDate }
+
+// IsTimeOfDay returns true if the field is a time-of-day field.
+func (f Field) IsTimeOfDay() bool { return f.Type != nil && f.Type.Type == field.TypeTimeOfDay }
+
+// IsDuration returns true if the field is a duration field.
+func (f Field) IsDuration() bool { return f.Type != nil && f.Type.Type == field.TypeDuration }
+
+// IsIP returns true if the field is an IP address field.
+func (f Field) IsIP() bool { return f.Type != nil && f.Type.Type == field.TypeIP }
+
+// IsPrefix returns true if the field is an IP network prefix field.
+func (f Field) IsPrefix() bool { return f.Type != nil && f.Type.Type == field.TypePrefix }
+
+// IsMAC returns true if the field is a MAC address field.
+func (f Field) IsMAC() bool { return f.Type != nil && f.Type.Type == field.TypeMAC }
+
 // IsJSON returns true if the field is a JSON field.
 func (f Field) IsJSON() bool { return f.Type != nil && f.Type.Type == field.TypeJSON }
 
@@ -1688,6 +1812,12 @@ func (f Field) StorageKey() string {
 	return snake(f.Name)
 }
 
+// IDBaseType returns the underlying type of typed ID fields, that were
+// generated using the FeatureTypedIDs option. For other fields, it is nil.
+func (f Field) IDBaseType() *field.TypeInfo {
+	return f.idBase
+}
+
 // HasGoType indicate if a basic field (like string or bool)
 // has a custom GoType.
 func (f Field) HasGoType() bool {
@@ -1825,7 +1955,8 @@ func (f Field) BasicType(ident string) (expr string) {
 		case rt.TypeEqual(nullStringType) || rt.TypeEqual(nullStringPType):
 			expr = fmt.Sprintf("%s.String", ident)
 		}
-	case field.TypeJSON:
+	case field.TypeJSON, field.TypeDate, field.TypeTimeOfDay, field.TypeDuration,
+		field.TypeIP, field.TypePrefix, field.TypeMAC:
 		expr = ident
 	default:
 		if t.Numeric() && rt.Kind >= reflect.Int && rt.Kind <= reflect.Float64 {
@@ -1880,6 +2011,65 @@ func (f *Field) Ops() []Op {
 	return ops
 }
 
+// hasOp reports if the field supports the given predicate operation.
+func (f *Field) hasOp(op Op) bool {
+	for _, o := range f.Ops() {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// Name returns the name of the lookup that is used for its generated methods.
+// For example, "Email" for a unique field, or "TenantAndSlug" for a unique index.
+func (l Lookup) Name() string {
+	names := make([]string, len(l.Fields))
+	for i, f := range l.Fields {
+		names[i] = f.StructField()
+	}
+	return strings.Join(names, "And")
+}
+
+// Params returns the names of the parameters of the lookup methods, one per field.
+func (l Lookup) Params() []string {
+	if len(l.Fields) == 1 {
+		return []string{"v"}
+	}
+	params := make([]string, len(l.Fields))
+	for i, f := range l.Fields {
+		switch p := camel(f.Name); {
+		case token.IsKeyword(p), p == "ctx", p == "c", p == l.pkg:
+			params[i] = p + "V"
+		default:
+			params[i] = p
+		}
+	}
+	return params
+}
+
+// Batchable reports if the lookup can be used for querying nodes in batch and keying them by
+// their field value. Only lookups of one field with a comparable Go type are supported.
+func (l Lookup) Batchable() bool {
+	return len(l.Fields) == 1 && l.Fields[0].hasOp(In) && l.Fields[0].mapKey()
+}
+
+// mapKey reports if the Go type of the field can be used as a map key.
+func (f *Field) mapKey() bool {
+	switch t := f.Type; {
+	case t.Type == field.TypeBytes || t.Type == field.TypeJSON:
+		return false
+	case t.Type == field.TypeTime:
+		// Standard time values are normalized before they are used as map
+		// keys, and custom time types cannot be normalized in the same way.
+		return !f.HasGoType()
+	case t.RType != nil:
+		return t.RType.Kind != reflect.Slice && t.RType.Kind != reflect.Map && t.RType.Kind != reflect.Func
+	default:
+		return true
+	}
+}
+
 // Label returns the Gremlin label name of the edge.
 // If the edge is inverse
 func (e Edge) Label() string {
@@ -5,6 +5,7 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
 
 	"entgo.io/ent/entc/load"
@@ -226,6 +227,44 @@ func TestType_AddIndex(t *testing.T) {
 	require.NoError(t, err, "valid index on M2O relation and field")
 }
 
+func TestType_Lookups(t *testing.T) {
+	typ, err := NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "type", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "user", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "blob", Info: &field.TypeInfo{Type: field.TypeBytes}, Unique: true},
+			{Name: "data", Info: &field.TypeInfo{Type: field.TypeJSON}, Unique: true},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Unique: true},
+			{Name: "expired_at", Info: &field.TypeInfo{Type: field.TypeTime, Ident: "types.Time", RType: &field.RType{Kind: reflect.Struct}}, Unique: true},
+		},
+	})
+	require.NoError(t, err)
+	typ.Edges = append(typ.Edges, &Edge{Name: "owner", Inverse: "files", Rel: Relation{Type: M2O, Columns: []string{"owner_id"}}})
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"tenant", "type", "user"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"tenant"}, Edges: []string{"owner"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"tenant"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"email"}}))
+	require.True(t, typ.HasMapKeyID())
+
+	lookups := typ.Lookups()
+	require.Len(t, lookups, 5)
+	require.Equal(t, "Email", lookups[0].Name())
+	require.Equal(t, []string{"v"}, lookups[0].Params())
+	require.True(t, lookups[0].Batchable())
+	require.Equal(t, "Blob", lookups[1].Name())
+	require.False(t, lookups[1].Batchable(), "bytes cannot be used as map keys")
+	require.Equal(t, "CreatedAt", lookups[2].Name())
+	require.True(t, lookups[2].Batchable(), "standard time values are normalized")
+	require.Equal(t, "ExpiredAt", lookups[3].Name())
+	require.False(t, lookups[3].Batchable(), "custom time types cannot be normalized")
+	require.Equal(t, "TenantAndTypeAndUser", lookups[4].Name())
+	require.Equal(t, []string{"tenant", "typeV", "userV"}, lookups[4].Params())
+	require.False(t, lookups[4].Batchable())
+}
+
 func TestField_Constant(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -241,6 +280,21 @@ func TestField_Constant(t *testing.T) {
 	}
 }
 
+func TestField_NetworkOps(t *testing.T) {
+	for _, tt := range []struct {
+		field *field.Descriptor
+		ops   []Op
+	}{
+		{field.IP("addr").Descriptor(), []Op{EQ, NEQ, In, NotIn}},
+		{field.Prefix("subnet").Descriptor(), []Op{EQ, NEQ, In, NotIn}},
+		{field.MAC("hwaddr").Descriptor(), []Op{EQ, NEQ, In, NotIn, GT, GTE, LT, LTE}},
+		{field.MAC("hwaddr").Optional().Descriptor(), []Op{EQ, NEQ, In, NotIn, GT, GTE, LT, LTE, IsNil, NotNil}},
+	} {
+		f := &Field{Name: tt.field.Name, Type: tt.field.Info, Optional: tt.field.Optional}
+		require.Equal(t, tt.ops, f.Ops(), tt.field.Name)
+	}
+}
+
 func TestField_DefaultName(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -316,6 +316,7 @@ func (_q *CommentQuery) GroupBy(field string, fields ...string) *CommentGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = comment.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -555,11 +556,11 @@ func (_g *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -567,6 +568,47 @@ func (_g *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// CommentGroupRow is a typed row that is returned by the CommentGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CommentGroupRow struct {
+	ID         int     `json:"id,omitempty" sql:"id"`
+	Text       string  `json:"text,omitempty" sql:"text"`
+	PostID     int     `json:"post_id,omitempty" sql:"post_id"`
+	Count      int     `json:"count,omitempty" sql:"count"`
+	SumPostID  int     `json:"sum_post_id,omitempty" sql:"sum_post_id"`
+	MinPostID  int     `json:"min_post_id,omitempty" sql:"min_post_id"`
+	MaxPostID  int     `json:"max_post_id,omitempty" sql:"max_post_id"`
+	MeanPostID float64 `json:"mean_post_id,omitempty" sql:"mean_post_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CommentGroupBy) Rows(ctx context.Context) ([]*CommentGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CommentGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CommentGroupBy) RowsX(ctx context.Context) []*CommentGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CommentSelect is the builder for selecting fields of Comment entities.
 type CommentSelect struct {
 	*CommentQuery
@@ -602,6 +644,9 @@ func (_s *CommentSelect) sqlScan(ctx context.Context, root *CommentQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -353,6 +353,7 @@ func (_q *PostQuery) GroupBy(field string, fields ...string) *PostGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = post.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +631,11 @@ func (_g *PostGroupBy) sqlScan(ctx context.Context, root *PostQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +643,47 @@ func (_g *PostGroupBy) sqlScan(ctx context.Context, root *PostQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// PostGroupRow is a typed row that is returned by the PostGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type PostGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	Text         string  `json:"text,omitempty" sql:"text"`
+	AuthorID     int     `json:"author_id,omitempty" sql:"author_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumAuthorID  int     `json:"sum_author_id,omitempty" sql:"sum_author_id"`
+	MinAuthorID  int     `json:"min_author_id,omitempty" sql:"min_author_id"`
+	MaxAuthorID  int     `json:"max_author_id,omitempty" sql:"max_author_id"`
+	MeanAuthorID float64 `json:"mean_author_id,omitempty" sql:"mean_author_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *PostGroupBy) Rows(ctx context.Context) ([]*PostGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*PostGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *PostGroupBy) RowsX(ctx context.Context) []*PostGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // PostSelect is the builder for selecting fields of Post entities.
 type PostSelect struct {
 	*PostQuery
@@ -677,6 +719,9 @@ func (_s *PostSelect) sqlScan(ctx context.Context, root *PostQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -317,6 +317,7 @@ func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -555,11 +556,11 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -567,6 +568,42 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupRow is a typed row that is returned by the UserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type UserGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupBy) Rows(ctx context.Context) ([]*UserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupBy) RowsX(ctx context.Context) []*UserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserSelect is the builder for selecting fields of User entities.
 type UserSelect struct {
 	*UserQuery
@@ -602,6 +639,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
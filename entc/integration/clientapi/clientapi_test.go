@@ -0,0 +1,84 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package clientapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/clientapi/ent"
+	"entgo.io/ent/entc/integration/clientapi/ent/entfake"
+	"entgo.io/ent/entc/integration/clientapi/ent/pet"
+	"entgo.io/ent/entc/integration/clientapi/ent/predicate"
+	"entgo.io/ent/entc/integration/clientapi/ent/user"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakePredicates(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		now      = time.Now()
+		nickname = "nati"
+		a8m      = &ent.User{ID: 1, Name: "a8m", Age: 30, Active: true, Score: 1.5, CreatedAt: now}
+		nati     = &ent.User{ID: 2, Name: "nati", Nickname: &nickname, Age: 20, CreatedAt: now.Add(-time.Hour)}
+	)
+	client, fake := entfake.NewClient()
+	fake.User.Return(a8m, nati)
+	tests := []struct {
+		p    predicate.User
+		want []int
+	}{
+		{p: user.ID(1), want: []int{1}},
+		{p: user.IDNEQ(1), want: []int{2}},
+		{p: user.NameNEQ("a8m"), want: []int{2}},
+		{p: user.IDIn(2, 1), want: []int{1, 2}},
+		{p: user.IDIn()},
+		{p: user.IDNotIn(2, 3), want: []int{1}},
+		{p: user.AgeGT(29), want: []int{1}},
+		{p: user.ScoreLTE(1), want: []int{2}},
+		{p: user.ScoreGTE(1), want: []int{1}},
+		{p: user.CreatedAtLT(now), want: []int{2}},
+		{p: user.NicknameIsNil(), want: []int{1}},
+		{p: user.NicknameNotNil(), want: []int{2}},
+		// NULL values do not satisfy comparisons, or their negations.
+		{p: user.NicknameNEQ("a"), want: []int{2}},
+		{p: user.Not(user.NicknameEQ("a")), want: []int{2}},
+		{p: user.Active(true), want: []int{1}},
+		{p: user.Active(false), want: []int{2}},
+		{p: user.NameHasPrefix("a8"), want: []int{1}},
+		{p: user.NameHasSuffix("ti"), want: []int{2}},
+		{p: user.NameContains("%")},
+		{p: user.NameEqualFold("A8M"), want: []int{1}},
+		{p: user.NameContainsFold("AT"), want: []int{2}},
+		{p: user.And(user.ID(1), user.AgeGT(40))},
+		{p: user.Or(user.ID(2), user.And(user.AgeGT(20), user.AgeLT(40))), want: []int{1, 2}},
+	}
+	for i, tt := range tests {
+		users, err := client.User.Query().Where(tt.p).All(ctx)
+		require.NoError(t, err, i)
+		var ids []int
+		for _, u := range users {
+			ids = append(ids, u.ID)
+		}
+		require.Equal(t, tt.want, ids, i)
+	}
+
+	n, err := client.User.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	u, err := client.UserAPI().Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, nati, u)
+
+	_, err = client.User.Query().Where(user.HasPetsWith(pet.Name("pedro"))).All(ctx)
+	require.Error(t, err, "predicates on edges cannot be evaluated without a database")
+	_, err = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C("unknown"), 1))
+	}).All(ctx)
+	require.EqualError(t, err, `entfake: evaluating the predicates of User query: entfake: unknown column "unknown" in predicate`)
+}
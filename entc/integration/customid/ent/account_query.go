@@ -318,6 +318,7 @@ func (_q *AccountQuery) GroupBy(field string, fields ...string) *AccountGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = account.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -557,11 +558,11 @@ func (_g *AccountGroupBy) sqlScan(ctx context.Context, root *AccountQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -569,6 +570,42 @@ func (_g *AccountGroupBy) sqlScan(ctx context.Context, root *AccountQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// AccountGroupRow is a typed row that is returned by the AccountGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type AccountGroupRow struct {
+	ID    sid.ID `json:"id,omitempty" sql:"id"`
+	Email string `json:"email,omitempty" sql:"email"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *AccountGroupBy) Rows(ctx context.Context) ([]*AccountGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*AccountGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *AccountGroupBy) RowsX(ctx context.Context) []*AccountGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // AccountSelect is the builder for selecting fields of Account entities.
 type AccountSelect struct {
 	*AccountQuery
@@ -604,6 +641,9 @@ func (_s *AccountSelect) sqlScan(ctx context.Context, root *AccountQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
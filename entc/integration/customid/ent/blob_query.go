@@ -389,6 +389,7 @@ func (_q *BlobQuery) GroupBy(field string, fields ...string) *BlobGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = blob.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -742,11 +743,11 @@ func (_g *BlobGroupBy) sqlScan(ctx context.Context, root *BlobQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -754,6 +755,46 @@ func (_g *BlobGroupBy) sqlScan(ctx context.Context, root *BlobQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// BlobGroupRow is a typed row that is returned by the BlobGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type BlobGroupRow struct {
+	ID        uuid.UUID `json:"id,omitempty" sql:"id"`
+	UUID      uuid.UUID `json:"uuid,omitempty" sql:"uuid"`
+	Count     int       `json:"count,omitempty" sql:"count"`
+	SumCount  int       `json:"sum_count,omitempty" sql:"sum_count"`
+	MinCount  int       `json:"min_count,omitempty" sql:"min_count"`
+	MaxCount  int       `json:"max_count,omitempty" sql:"max_count"`
+	MeanCount float64   `json:"mean_count,omitempty" sql:"mean_count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *BlobGroupBy) Rows(ctx context.Context) ([]*BlobGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*BlobGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *BlobGroupBy) RowsX(ctx context.Context) []*BlobGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // BlobSelect is the builder for selecting fields of Blob entities.
 type BlobSelect struct {
 	*BlobQuery
@@ -789,6 +830,9 @@ func (_s *BlobSelect) sqlScan(ctx context.Context, root *BlobQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
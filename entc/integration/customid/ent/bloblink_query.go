@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -279,6 +280,7 @@ func (_q *BlobLinkQuery) GroupBy(field string, fields ...string) *BlobLinkGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = bloblink.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -552,11 +554,11 @@ func (_g *BlobLinkGroupBy) sqlScan(ctx context.Context, root *BlobLinkQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -564,6 +566,45 @@ func (_g *BlobLinkGroupBy) sqlScan(ctx context.Context, root *BlobLinkQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// BlobLinkGroupRow is a typed row that is returned by the BlobLinkGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type BlobLinkGroupRow struct {
+	CreatedAt time.Time `json:"created_at,omitempty" sql:"created_at"`
+	BlobID    uuid.UUID `json:"blob_id,omitempty" sql:"blob_id"`
+	LinkID    uuid.UUID `json:"link_id,omitempty" sql:"link_id"`
+	Bucket    time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count     int       `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *BlobLinkGroupBy) Rows(ctx context.Context) ([]*BlobLinkGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*BlobLinkGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *BlobLinkGroupBy) RowsX(ctx context.Context) []*BlobLinkGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // BlobLinkSelect is the builder for selecting fields of BlobLink entities.
 type BlobLinkSelect struct {
 	*BlobLinkQuery
@@ -599,6 +640,9 @@ func (_s *BlobLinkSelect) sqlScan(ctx context.Context, root *BlobLinkQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
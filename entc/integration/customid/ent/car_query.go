@@ -317,6 +317,7 @@ func (_q *CarQuery) GroupBy(field string, fields ...string) *CarGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = car.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -563,11 +564,11 @@ func (_g *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -575,6 +576,52 @@ func (_g *CarGroupBy) sqlScan(ctx context.Context, root *CarQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// CarGroupRow is a typed row that is returned by the CarGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CarGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	BeforeID     float64 `json:"before_id,omitempty" sql:"before_id"`
+	AfterID      float64 `json:"after_id,omitempty" sql:"after_id"`
+	Model        string  `json:"model,omitempty" sql:"model"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumBeforeID  float64 `json:"sum_before_id,omitempty" sql:"sum_before_id"`
+	MinBeforeID  float64 `json:"min_before_id,omitempty" sql:"min_before_id"`
+	MaxBeforeID  float64 `json:"max_before_id,omitempty" sql:"max_before_id"`
+	MeanBeforeID float64 `json:"mean_before_id,omitempty" sql:"mean_before_id"`
+	SumAfterID   float64 `json:"sum_after_id,omitempty" sql:"sum_after_id"`
+	MinAfterID   float64 `json:"min_after_id,omitempty" sql:"min_after_id"`
+	MaxAfterID   float64 `json:"max_after_id,omitempty" sql:"max_after_id"`
+	MeanAfterID  float64 `json:"mean_after_id,omitempty" sql:"mean_after_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CarGroupBy) Rows(ctx context.Context) ([]*CarGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CarGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CarGroupBy) RowsX(ctx context.Context) []*CarGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CarSelect is the builder for selecting fields of Car entities.
 type CarSelect struct {
 	*CarQuery
@@ -610,6 +657,9 @@ func (_s *CarSelect) sqlScan(ctx context.Context, root *CarQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
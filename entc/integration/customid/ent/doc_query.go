@@ -388,6 +388,7 @@ func (_q *DocQuery) GroupBy(field string, fields ...string) *DocGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = doc.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -742,11 +743,11 @@ func (_g *DocGroupBy) sqlScan(ctx context.Context, root *DocQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -754,6 +755,42 @@ func (_g *DocGroupBy) sqlScan(ctx context.Context, root *DocQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// DocGroupRow is a typed row that is returned by the DocGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type DocGroupRow struct {
+	ID    schema.DocID `json:"id,omitempty" sql:"id"`
+	Text  string       `json:"text,omitempty" sql:"text"`
+	Count int          `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *DocGroupBy) Rows(ctx context.Context) ([]*DocGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*DocGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *DocGroupBy) RowsX(ctx context.Context) []*DocGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // DocSelect is the builder for selecting fields of Doc entities.
 type DocSelect struct {
 	*DocQuery
@@ -789,6 +826,9 @@ func (_s *DocSelect) sqlScan(ctx context.Context, root *DocQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -341,6 +341,7 @@ func (_q *IntSIDQuery) GroupBy(field string, fields ...string) *IntSIDGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = intsid.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -616,11 +617,11 @@ func (_g *IntSIDGroupBy) sqlScan(ctx context.Context, root *IntSIDQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -628,6 +629,41 @@ func (_g *IntSIDGroupBy) sqlScan(ctx context.Context, root *IntSIDQuery, v any)
 	return sql.ScanSlice(rows, v)
 }
 
+// IntSIDGroupRow is a typed row that is returned by the IntSIDGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type IntSIDGroupRow struct {
+	ID    sid.ID `json:"id,omitempty" sql:"id"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *IntSIDGroupBy) Rows(ctx context.Context) ([]*IntSIDGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*IntSIDGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *IntSIDGroupBy) RowsX(ctx context.Context) []*IntSIDGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // IntSIDSelect is the builder for selecting fields of IntSID entities.
 type IntSIDSelect struct {
 	*IntSIDQuery
@@ -663,6 +699,9 @@ func (_s *IntSIDSelect) sqlScan(ctx context.Context, root *IntSIDQuery, v any) e
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/customid/ent/link"
 	"entgo.io/ent/entc/integration/customid/ent/predicate"
+	"entgo.io/ent/entc/integration/customid/ent/schema"
 	uuidc "entgo.io/ent/entc/integration/customid/uuidcompatible"
 	"entgo.io/ent/schema/field"
 )
@@ -281,6 +282,7 @@ func (_q *LinkQuery) GroupBy(field string, fields ...string) *LinkGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = link.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -477,11 +479,11 @@ func (_g *LinkGroupBy) sqlScan(ctx context.Context, root *LinkQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -489,6 +491,42 @@ func (_g *LinkGroupBy) sqlScan(ctx context.Context, root *LinkQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// LinkGroupRow is a typed row that is returned by the LinkGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type LinkGroupRow struct {
+	ID              uuidc.UUIDC                       `json:"id,omitempty" sql:"id"`
+	LinkInformation map[string]schema.LinkInformation `json:"link_information,omitempty" sql:"link_information"`
+	Count           int                               `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *LinkGroupBy) Rows(ctx context.Context) ([]*LinkGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*LinkGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *LinkGroupBy) RowsX(ctx context.Context) []*LinkGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // LinkSelect is the builder for selecting fields of Link entities.
 type LinkSelect struct {
 	*LinkQuery
@@ -524,6 +562,9 @@ func (_s *LinkSelect) sqlScan(ctx context.Context, root *LinkQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
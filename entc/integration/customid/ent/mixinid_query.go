@@ -281,6 +281,7 @@ func (_q *MixinIDQuery) GroupBy(field string, fields ...string) *MixinIDGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = mixinid.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -477,11 +478,11 @@ func (_g *MixinIDGroupBy) sqlScan(ctx context.Context, root *MixinIDQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -489,6 +490,43 @@ func (_g *MixinIDGroupBy) sqlScan(ctx context.Context, root *MixinIDQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// MixinIDGroupRow is a typed row that is returned by the MixinIDGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type MixinIDGroupRow struct {
+	ID         uuid.UUID `json:"id,omitempty" sql:"id"`
+	SomeField  string    `json:"some_field,omitempty" sql:"some_field"`
+	MixinField string    `json:"mixin_field,omitempty" sql:"mixin_field"`
+	Count      int       `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *MixinIDGroupBy) Rows(ctx context.Context) ([]*MixinIDGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*MixinIDGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *MixinIDGroupBy) RowsX(ctx context.Context) []*MixinIDGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MixinIDSelect is the builder for selecting fields of MixinID entities.
 type MixinIDSelect struct {
 	*MixinIDQuery
@@ -524,6 +562,9 @@ func (_s *MixinIDSelect) sqlScan(ctx context.Context, root *MixinIDQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -353,6 +353,7 @@ func (_q *NoteQuery) GroupBy(field string, fields ...string) *NoteGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = note.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -638,11 +639,11 @@ func (_g *NoteGroupBy) sqlScan(ctx context.Context, root *NoteQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -650,6 +651,42 @@ func (_g *NoteGroupBy) sqlScan(ctx context.Context, root *NoteQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// NoteGroupRow is a typed row that is returned by the NoteGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type NoteGroupRow struct {
+	ID    schema.NoteID `json:"id,omitempty" sql:"id"`
+	Text  string        `json:"text,omitempty" sql:"text"`
+	Count int           `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *NoteGroupBy) Rows(ctx context.Context) ([]*NoteGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*NoteGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *NoteGroupBy) RowsX(ctx context.Context) []*NoteGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // NoteSelect is the builder for selecting fields of Note entities.
 type NoteSelect struct {
 	*NoteQuery
@@ -685,6 +722,9 @@ func (_s *NoteSelect) sqlScan(ctx context.Context, root *NoteQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -269,6 +269,7 @@ func (_q *OtherQuery) GroupBy(field string, fields ...string) *OtherGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = other.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -455,11 +456,11 @@ func (_g *OtherGroupBy) sqlScan(ctx context.Context, root *OtherQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -467,6 +468,41 @@ func (_g *OtherGroupBy) sqlScan(ctx context.Context, root *OtherQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// OtherGroupRow is a typed row that is returned by the OtherGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type OtherGroupRow struct {
+	ID    sid.ID `json:"id,omitempty" sql:"id"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *OtherGroupBy) Rows(ctx context.Context) ([]*OtherGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*OtherGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *OtherGroupBy) RowsX(ctx context.Context) []*OtherGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // OtherSelect is the builder for selecting fields of Other entities.
 type OtherSelect struct {
 	*OtherQuery
@@ -502,6 +538,9 @@ func (_s *OtherSelect) sqlScan(ctx context.Context, root *OtherQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -268,6 +268,7 @@ func (_q *RevisionQuery) GroupBy(field string, fields ...string) *RevisionGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = revision.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -454,11 +455,11 @@ func (_g *RevisionGroupBy) sqlScan(ctx context.Context, root *RevisionQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -466,6 +467,41 @@ func (_g *RevisionGroupBy) sqlScan(ctx context.Context, root *RevisionQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// RevisionGroupRow is a typed row that is returned by the RevisionGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type RevisionGroupRow struct {
+	ID    string `json:"id,omitempty" sql:"id"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *RevisionGroupBy) Rows(ctx context.Context) ([]*RevisionGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*RevisionGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *RevisionGroupBy) RowsX(ctx context.Context) []*RevisionGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // RevisionSelect is the builder for selecting fields of Revision entities.
 type RevisionSelect struct {
 	*RevisionQuery
@@ -501,6 +537,9 @@ func (_s *RevisionSelect) sqlScan(ctx context.Context, root *RevisionQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -318,6 +318,7 @@ func (_q *TokenQuery) GroupBy(field string, fields ...string) *TokenGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = token.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -564,11 +565,11 @@ func (_g *TokenGroupBy) sqlScan(ctx context.Context, root *TokenQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -576,6 +577,42 @@ func (_g *TokenGroupBy) sqlScan(ctx context.Context, root *TokenQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// TokenGroupRow is a typed row that is returned by the TokenGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type TokenGroupRow struct {
+	ID    sid.ID `json:"id,omitempty" sql:"id"`
+	Body  string `json:"body,omitempty" sql:"body"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TokenGroupBy) Rows(ctx context.Context) ([]*TokenGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TokenGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TokenGroupBy) RowsX(ctx context.Context) []*TokenGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TokenSelect is the builder for selecting fields of Token entities.
 type TokenSelect struct {
 	*TokenQuery
@@ -611,6 +648,9 @@ func (_s *TokenSelect) sqlScan(ctx context.Context, root *TokenQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -316,6 +316,7 @@ func (_q *CardQuery) GroupBy(field string, fields ...string) *CardGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = card.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -555,11 +556,11 @@ func (_g *CardGroupBy) sqlScan(ctx context.Context, root *CardQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -567,6 +568,47 @@ func (_g *CardGroupBy) sqlScan(ctx context.Context, root *CardQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// CardGroupRow is a typed row that is returned by the CardGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CardGroupRow struct {
+	ID          int     `json:"id,omitempty" sql:"id"`
+	Number      string  `json:"number,omitempty" sql:"number"`
+	OwnerID     int     `json:"owner_id,omitempty" sql:"owner_id"`
+	Count       int     `json:"count,omitempty" sql:"count"`
+	SumOwnerID  int     `json:"sum_owner_id,omitempty" sql:"sum_owner_id"`
+	MinOwnerID  int     `json:"min_owner_id,omitempty" sql:"min_owner_id"`
+	MaxOwnerID  int     `json:"max_owner_id,omitempty" sql:"max_owner_id"`
+	MeanOwnerID float64 `json:"mean_owner_id,omitempty" sql:"mean_owner_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CardGroupBy) Rows(ctx context.Context) ([]*CardGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CardGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CardGroupBy) RowsX(ctx context.Context) []*CardGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CardSelect is the builder for selecting fields of Card entities.
 type CardSelect struct {
 	*CardQuery
@@ -602,6 +644,9 @@ func (_s *CardSelect) sqlScan(ctx context.Context, root *CardQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 
@@ -316,6 +317,7 @@ func (_q *InfoQuery) GroupBy(field string, fields ...string) *InfoGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = info.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -552,11 +554,11 @@ func (_g *InfoGroupBy) sqlScan(ctx context.Context, root *InfoQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -564,6 +566,42 @@ func (_g *InfoGroupBy) sqlScan(ctx context.Context, root *InfoQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// InfoGroupRow is a typed row that is returned by the InfoGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type InfoGroupRow struct {
+	ID      int             `json:"id,omitempty" sql:"id"`
+	Content json.RawMessage `json:"content,omitempty" sql:"content"`
+	Count   int             `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *InfoGroupBy) Rows(ctx context.Context) ([]*InfoGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*InfoGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *InfoGroupBy) RowsX(ctx context.Context) []*InfoGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // InfoSelect is the builder for selecting fields of Info entities.
 type InfoSelect struct {
 	*InfoQuery
@@ -599,6 +637,9 @@ func (_s *InfoSelect) sqlScan(ctx context.Context, root *InfoQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -388,6 +388,7 @@ func (_q *MetadataQuery) GroupBy(field string, fields ...string) *MetadataGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = metadata.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -722,11 +723,11 @@ func (_g *MetadataGroupBy) sqlScan(ctx context.Context, root *MetadataQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -734,6 +735,51 @@ func (_g *MetadataGroupBy) sqlScan(ctx context.Context, root *MetadataQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// MetadataGroupRow is a typed row that is returned by the MetadataGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type MetadataGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	Age          int     `json:"age,omitempty" sql:"age"`
+	ParentID     int     `json:"parent_id,omitempty" sql:"parent_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumAge       int     `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge       int     `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge       int     `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge      float64 `json:"mean_age,omitempty" sql:"mean_age"`
+	SumParentID  int     `json:"sum_parent_id,omitempty" sql:"sum_parent_id"`
+	MinParentID  int     `json:"min_parent_id,omitempty" sql:"min_parent_id"`
+	MaxParentID  int     `json:"max_parent_id,omitempty" sql:"max_parent_id"`
+	MeanParentID float64 `json:"mean_parent_id,omitempty" sql:"mean_parent_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *MetadataGroupBy) Rows(ctx context.Context) ([]*MetadataGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*MetadataGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *MetadataGroupBy) RowsX(ctx context.Context) []*MetadataGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MetadataSelect is the builder for selecting fields of Metadata entities.
 type MetadataSelect struct {
 	*MetadataQuery
@@ -769,6 +815,9 @@ func (_s *MetadataSelect) sqlScan(ctx context.Context, root *MetadataQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -353,6 +354,7 @@ func (_q *RentalQuery) GroupBy(field string, fields ...string) *RentalGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = rental.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -631,11 +633,11 @@ func (_g *RentalGroupBy) sqlScan(ctx context.Context, root *RentalQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -643,6 +645,50 @@ func (_g *RentalGroupBy) sqlScan(ctx context.Context, root *RentalQuery, v any)
 	return sql.ScanSlice(rows, v)
 }
 
+// RentalGroupRow is a typed row that is returned by the RentalGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type RentalGroupRow struct {
+	ID         int       `json:"id,omitempty" sql:"id"`
+	Date       time.Time `json:"date,omitempty" sql:"date"`
+	UserID     int       `json:"user_id,omitempty" sql:"user_id"`
+	CarID      uuid.UUID `json:"car_id,omitempty" sql:"car_id"`
+	Bucket     time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count      int       `json:"count,omitempty" sql:"count"`
+	SumUserID  int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID  int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID  int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *RentalGroupBy) Rows(ctx context.Context) ([]*RentalGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*RentalGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *RentalGroupBy) RowsX(ctx context.Context) []*RentalGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // RentalSelect is the builder for selecting fields of Rental entities.
 type RentalSelect struct {
 	*RentalQuery
@@ -678,6 +724,9 @@ func (_s *RentalSelect) sqlScan(ctx context.Context, root *RentalQuery, v any) e
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
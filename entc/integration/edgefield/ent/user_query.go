@@ -570,6 +570,7 @@ func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -1146,11 +1147,11 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -1158,6 +1159,51 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupRow is a typed row that is returned by the UserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type UserGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	ParentID     int     `json:"parent_id,omitempty" sql:"parent_id"`
+	SpouseID     int     `json:"spouse_id,omitempty" sql:"spouse_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumParentID  int     `json:"sum_parent_id,omitempty" sql:"sum_parent_id"`
+	MinParentID  int     `json:"min_parent_id,omitempty" sql:"min_parent_id"`
+	MaxParentID  int     `json:"max_parent_id,omitempty" sql:"max_parent_id"`
+	MeanParentID float64 `json:"mean_parent_id,omitempty" sql:"mean_parent_id"`
+	SumSpouseID  int     `json:"sum_spouse_id,omitempty" sql:"sum_spouse_id"`
+	MinSpouseID  int     `json:"min_spouse_id,omitempty" sql:"min_spouse_id"`
+	MaxSpouseID  int     `json:"max_spouse_id,omitempty" sql:"max_spouse_id"`
+	MeanSpouseID float64 `json:"mean_spouse_id,omitempty" sql:"mean_spouse_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupBy) Rows(ctx context.Context) ([]*UserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupBy) RowsX(ctx context.Context) []*UserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserSelect is the builder for selecting fields of User entities.
 type UserSelect struct {
 	*UserQuery
@@ -1193,6 +1239,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -352,6 +353,7 @@ func (_q *AttachedFileQuery) GroupBy(field string, fields ...string) *AttachedFi
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = attachedfile.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +632,11 @@ func (_g *AttachedFileGroupBy) sqlScan(ctx context.Context, root *AttachedFileQu
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +644,54 @@ func (_g *AttachedFileGroupBy) sqlScan(ctx context.Context, root *AttachedFileQu
 	return sql.ScanSlice(rows, v)
 }
 
+// AttachedFileGroupRow is a typed row that is returned by the AttachedFileGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type AttachedFileGroupRow struct {
+	ID         int       `json:"id,omitempty" sql:"id"`
+	AttachTime time.Time `json:"attach_time,omitempty" sql:"attach_time"`
+	FID        int       `json:"f_id,omitempty" sql:"f_id"`
+	ProcID     int       `json:"proc_id,omitempty" sql:"proc_id"`
+	Bucket     time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count      int       `json:"count,omitempty" sql:"count"`
+	SumFID     int       `json:"sum_f_id,omitempty" sql:"sum_f_id"`
+	MinFID     int       `json:"min_f_id,omitempty" sql:"min_f_id"`
+	MaxFID     int       `json:"max_f_id,omitempty" sql:"max_f_id"`
+	MeanFID    float64   `json:"mean_f_id,omitempty" sql:"mean_f_id"`
+	SumProcID  int       `json:"sum_proc_id,omitempty" sql:"sum_proc_id"`
+	MinProcID  int       `json:"min_proc_id,omitempty" sql:"min_proc_id"`
+	MaxProcID  int       `json:"max_proc_id,omitempty" sql:"max_proc_id"`
+	MeanProcID float64   `json:"mean_proc_id,omitempty" sql:"mean_proc_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *AttachedFileGroupBy) Rows(ctx context.Context) ([]*AttachedFileGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*AttachedFileGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *AttachedFileGroupBy) RowsX(ctx context.Context) []*AttachedFileGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // AttachedFileSelect is the builder for selecting fields of AttachedFile entities.
 type AttachedFileSelect struct {
 	*AttachedFileQuery
@@ -677,6 +727,9 @@ func (_s *AttachedFileSelect) sqlScan(ctx context.Context, root *AttachedFileQue
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
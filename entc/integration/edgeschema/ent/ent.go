@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -149,13 +151,549 @@ type AggregateFunc func(*sql.Selector) string
 //	Scan(ctx, &v)
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		return sql.As(fn(s), end)
+		expr := fn(s)
+		// Renamed results are not part of the typed rows.
+		reportAggregate(s, "")
+		return sql.As(expr, end)
 	}
 }
 
+// Time units for the Bucket aggregation function.
+const (
+	Minute = sql.Minute
+	Hour   = sql.Hour
+	Day    = sql.Day
+	Week   = sql.Week
+	Month  = sql.Month
+	Year   = sql.Year
+)
+
+// Bucket groups the rows by the given time field truncated to the given unit in UTC,
+// and selects the start time of each bucket. For example:
+//
+//	client.User.Query().
+//		GroupBy(user.FieldRole).
+//		Aggregate(ent.Bucket(user.FieldCreatedAt, ent.Day), ent.Count()).
+//		Rows(ctx)
+func Bucket(field string, unit sql.TimeUnit) AggregateFunc {
+	return BucketIn(field, unit, time.UTC)
+}
+
+// BucketIn is like Bucket, but truncates the time field in the given location.
+// The location must be loaded by its IANA name (e.g. "Europe/Berlin").
+func BucketIn(field string, unit sql.TimeUnit, loc *time.Location) AggregateFunc {
+	return func(s *sql.Selector) string {
+		c, err := groupColumn(s, field)
+		if err != nil {
+			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		if loc == nil || loc == time.Local {
+			s.AddError(fmt.Errorf("ent: missing named location for time bucket of field %q", field))
+			return ""
+		}
+		x := sql.Col(c).Trunc(unit, loc.String())
+		x.SetDialect(s.Dialect())
+		expr, _ := x.Query()
+		if err := x.Err(); err != nil {
+			s.AddError(fmt.Errorf("ent: %w", err))
+			return ""
+		}
+		s.GroupBy(expr)
+		return sql.As(expr, "bucket")
+	}
+}
+
+// aggregateKey is the context key of the aggregation result that is reported
+// by the aggregation functions, and is used by the typed group-by rows.
+type aggregateKey struct{}
+
+// aggregateResult describes the result of an aggregation function.
+type aggregateResult struct {
+	name string // Column name of the result (e.g. "sum_age"), or empty if it is not a row field.
+}
+
+// reportAggregate reports the column name of the result of an aggregation function,
+// if it was requested by the typed group-by rows. The name is named after the function
+// and the aggregated column. For example, "sum_age" for the Sum function of the "age"
+// field. An empty name indicates the result is not part of the typed rows.
+func reportAggregate(s *sql.Selector, name string) {
+	if r, ok := s.Context().Value(aggregateKey{}).(*aggregateResult); ok {
+		r.name = name
+	}
+}
+
+// aggregateAs names the result of the aggregation function after the name that
+// was reported by the function. It is used for scanning the aggregation results
+// into the typed group-by rows. Results of functions that do not report their
+// names (e.g. custom functions) are returned as is.
+func aggregateAs(fn AggregateFunc) AggregateFunc {
+	return func(s *sql.Selector) string {
+		var (
+			r   = &aggregateResult{}
+			ctx = s.Context()
+		)
+		s.WithContext(context.WithValue(ctx, aggregateKey{}, r))
+		expr := fn(s)
+		s.WithContext(ctx)
+		if r.name == "" || expr == "" {
+			return expr
+		}
+		return sql.As(expr, r.name)
+	}
+}
+
+// EdgeField returns a reference to a field of the neighbors of the given edge. It can be
+// used for grouping and aggregating rows by the fields of their neighbors. For example:
+//
+//	client.User.Query().
+//		GroupBy(user.FieldCountry, ent.EdgeField(user.EdgeCompany, company.FieldIndustry)).
+//		Aggregate(ent.Sum(ent.EdgeField(user.EdgeOrders, order.FieldAmount))).
+//		Scan(ctx, &v)
+//
+// Edge fields are selected as "<edge>_<field>", for example, "company_industry".
+func EdgeField(edge, field string) string {
+	return edge + "." + field
+}
+
+// groupByEdgeFields splits the given group-by fields into the fields of the node,
+// and aggregation functions that group the rows by the given edge fields.
+func groupByEdgeFields(fields []string) ([]string, []AggregateFunc) {
+	var (
+		own = make([]string, 0, len(fields))
+		fns []AggregateFunc
+	)
+	for _, f := range fields {
+		if !strings.Contains(f, ".") {
+			own = append(own, f)
+			continue
+		}
+		fns = append(fns, func(s *sql.Selector) string {
+			c, err := groupColumn(s, f)
+			if err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			s.GroupBy(c)
+			return sql.As(c, strings.ReplaceAll(f, ".", "_"))
+		})
+	}
+	return own, fns
+}
+
+// groupColumn returns the column of the given field in the selector. Edge fields
+// are resolved by joining the neighbors of the edge to the selector. Fields of
+// non-unique edges are rejected, as their neighbors would multiply the rows of
+// the selector, and the results of the aggregation functions accordingly.
+func groupColumn(s *sql.Selector, field string) (string, error) {
+	edge, column, ok := strings.Cut(field, ".")
+	if !ok {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			return "", err
+		}
+		return s.C(field), nil
+	}
+	step, err := edgeStep(s, edge)
+	if err != nil {
+		return "", err
+	}
+	if step.Edge.Rel == sqlgraph.O2M || step.Edge.Rel == sqlgraph.M2M {
+		return "", fmt.Errorf("cannot group by field %q of non-unique edge %q", column, edge)
+	}
+	if err := checkColumn(step.To.Table, column); err != nil {
+		return "", err
+	}
+	return sqlgraph.JoinNeighbors(s, step).C(column), nil
+}
+
+// aggregateEdgeField applies the aggregation function (e.g. "Sum") on the given edge field.
+// The neighbors are aggregated for each node in a sub-query, and the results are aggregated
+// again by the selector. Hence, the neighbors do not multiply the rows of the selector.
+func aggregateEdgeField(s *sql.Selector, fn, field string) (string, error) {
+	edge, column, _ := strings.Cut(field, ".")
+	step, err := edgeStep(s, edge)
+	if err != nil {
+		return "", err
+	}
+	if err := checkColumn(step.To.Table, column); err != nil {
+		return "", err
+	}
+	aggs := map[string]func(string) string{"Sum": sql.Sum, "Min": sql.Min, "Max": sql.Max, "Count": sql.Count}
+	neighbors := func(fn string) string {
+		return sqlgraph.AggregateNeighbors(s, step, strings.ToLower(fn)+"_"+column, func(t *sql.SelectTable) string {
+			return aggs[fn](t.C(column))
+		})
+	}
+	if fn == "Mean" {
+		// The mean of the neighbors is the sum of their values divided by their number.
+		return fmt.Sprintf("%s * 1.0 / NULLIF(%s, 0)", sql.Sum(neighbors("Sum")), sql.Sum(neighbors("Count"))), nil
+	}
+	return aggs[fn](neighbors(fn)), nil
+}
+
+// edgeStep returns the step from the table of the selector to the neighbors of the given edge.
+func edgeStep(s *sql.Selector, edge string) (*sqlgraph.Step, error) {
+	var step *sqlgraph.Step
+	switch s.TableName() {
+	case attachedfile.Table:
+		switch edge {
+		case attachedfile.EdgeFi:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(attachedfile.Table, attachedfile.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, attachedfile.FiTable, attachedfile.FiColumn),
+			)
+		case attachedfile.EdgeProc:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(attachedfile.Table, attachedfile.FieldID),
+				sqlgraph.To(process.Table, process.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, attachedfile.ProcTable, attachedfile.ProcColumn),
+			)
+		}
+	case file.Table:
+		switch edge {
+		case file.EdgeProcesses:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(process.Table, process.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, file.ProcessesTable, file.ProcessesPrimaryKey...),
+			)
+		}
+	case friendship.Table:
+		switch edge {
+		case friendship.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(friendship.Table, friendship.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, friendship.UserTable, friendship.UserColumn),
+			)
+		case friendship.EdgeFriend:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(friendship.Table, friendship.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, friendship.FriendTable, friendship.FriendColumn),
+			)
+		}
+	case group.Table:
+		switch edge {
+		case group.EdgeUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+			)
+		case group.EdgeTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, group.TagsTable, group.TagsPrimaryKey...),
+			)
+		case group.EdgeJoinedUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(usergroup.Table, usergroup.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, group.JoinedUsersTable, group.JoinedUsersColumn),
+			)
+		case group.EdgeGroupTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(grouptag.Table, grouptag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, group.GroupTagsTable, group.GroupTagsColumn),
+			)
+		}
+	case grouptag.Table:
+		switch edge {
+		case grouptag.EdgeTag:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(grouptag.Table, grouptag.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, grouptag.TagTable, grouptag.TagColumn),
+			)
+		case grouptag.EdgeGroup:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(grouptag.Table, grouptag.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, grouptag.GroupTable, grouptag.GroupColumn),
+			)
+		}
+	case process.Table:
+		switch edge {
+		case process.EdgeFiles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(process.Table, process.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, process.FilesTable, process.FilesPrimaryKey...),
+			)
+		case process.EdgeAttachedFiles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(process.Table, process.FieldID),
+				sqlgraph.To(attachedfile.Table, attachedfile.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, process.AttachedFilesTable, process.AttachedFilesColumn),
+			)
+		}
+	case relationship.Table:
+		switch edge {
+		case relationship.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(relationship.Table, relationship.UserColumn),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, relationship.UserTable, relationship.UserColumn),
+			)
+		case relationship.EdgeRelative:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(relationship.Table, relationship.RelativeColumn),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, relationship.RelativeTable, relationship.RelativeColumn),
+			)
+		case relationship.EdgeInfo:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(relationship.Table, relationship.InfoColumn),
+				sqlgraph.To(relationshipinfo.Table, relationshipinfo.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, relationship.InfoTable, relationship.InfoColumn),
+			)
+		}
+	case role.Table:
+		switch edge {
+		case role.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(role.Table, role.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, role.UserTable, role.UserPrimaryKey...),
+			)
+		case role.EdgeRolesUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(role.Table, role.FieldID),
+				sqlgraph.To(roleuser.Table, roleuser.RoleColumn),
+				sqlgraph.Edge(sqlgraph.O2M, true, role.RolesUsersTable, role.RolesUsersColumn),
+			)
+		}
+	case roleuser.Table:
+		switch edge {
+		case roleuser.EdgeRole:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(roleuser.Table, roleuser.RoleColumn),
+				sqlgraph.To(role.Table, role.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, roleuser.RoleTable, roleuser.RoleColumn),
+			)
+		case roleuser.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(roleuser.Table, roleuser.UserColumn),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, roleuser.UserTable, roleuser.UserColumn),
+			)
+		}
+	case tag.Table:
+		switch edge {
+		case tag.EdgeTweets:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, tag.TweetsTable, tag.TweetsPrimaryKey...),
+			)
+		case tag.EdgeGroups:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, tag.GroupsTable, tag.GroupsPrimaryKey...),
+			)
+		case tag.EdgeTweetTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(tweettag.Table, tweettag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tag.TweetTagsTable, tag.TweetTagsColumn),
+			)
+		case tag.EdgeGroupTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tag.Table, tag.FieldID),
+				sqlgraph.To(grouptag.Table, grouptag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tag.GroupTagsTable, tag.GroupTagsColumn),
+			)
+		}
+	case tweet.Table:
+		switch edge {
+		case tweet.EdgeLikedUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.LikedUsersTable, tweet.LikedUsersPrimaryKey...),
+			)
+		case tweet.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.UserTable, tweet.UserPrimaryKey...),
+			)
+		case tweet.EdgeTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, tweet.TagsTable, tweet.TagsPrimaryKey...),
+			)
+		case tweet.EdgeLikes:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(tweetlike.Table, tweetlike.TweetColumn),
+				sqlgraph.Edge(sqlgraph.O2M, true, tweet.LikesTable, tweet.LikesColumn),
+			)
+		case tweet.EdgeTweetUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(usertweet.Table, usertweet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetUserTable, tweet.TweetUserColumn),
+			)
+		case tweet.EdgeTweetTags:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweet.Table, tweet.FieldID),
+				sqlgraph.To(tweettag.Table, tweettag.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, tweet.TweetTagsTable, tweet.TweetTagsColumn),
+			)
+		}
+	case tweetlike.Table:
+		switch edge {
+		case tweetlike.EdgeTweet:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweetlike.Table, tweetlike.TweetColumn),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweetlike.TweetTable, tweetlike.TweetColumn),
+			)
+		case tweetlike.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweetlike.Table, tweetlike.UserColumn),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweetlike.UserTable, tweetlike.UserColumn),
+			)
+		}
+	case tweettag.Table:
+		switch edge {
+		case tweettag.EdgeTag:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweettag.Table, tweettag.FieldID),
+				sqlgraph.To(tag.Table, tag.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweettag.TagTable, tweettag.TagColumn),
+			)
+		case tweettag.EdgeTweet:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(tweettag.Table, tweettag.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, tweettag.TweetTable, tweettag.TweetColumn),
+			)
+		}
+	case user.Table:
+		switch edge {
+		case user.EdgeGroups:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+			)
+		case user.EdgeFriends:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			)
+		case user.EdgeRelatives:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.RelativesTable, user.RelativesPrimaryKey...),
+			)
+		case user.EdgeLikedTweets:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.LikedTweetsTable, user.LikedTweetsPrimaryKey...),
+			)
+		case user.EdgeTweets:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.TweetsTable, user.TweetsPrimaryKey...),
+			)
+		case user.EdgeRoles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(role.Table, role.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+			)
+		case user.EdgeJoinedGroups:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(usergroup.Table, usergroup.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.JoinedGroupsTable, user.JoinedGroupsColumn),
+			)
+		case user.EdgeFriendships:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(friendship.Table, friendship.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.FriendshipsTable, user.FriendshipsColumn),
+			)
+		case user.EdgeRelationship:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(relationship.Table, relationship.UserColumn),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.RelationshipTable, user.RelationshipColumn),
+			)
+		case user.EdgeLikes:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(tweetlike.Table, tweetlike.UserColumn),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.LikesTable, user.LikesColumn),
+			)
+		case user.EdgeUserTweets:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(usertweet.Table, usertweet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.UserTweetsTable, user.UserTweetsColumn),
+			)
+		case user.EdgeRolesUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(roleuser.Table, roleuser.UserColumn),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.RolesUsersTable, user.RolesUsersColumn),
+			)
+		}
+	case usergroup.Table:
+		switch edge {
+		case usergroup.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(usergroup.Table, usergroup.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usergroup.UserTable, usergroup.UserColumn),
+			)
+		case usergroup.EdgeGroup:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(usergroup.Table, usergroup.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usergroup.GroupTable, usergroup.GroupColumn),
+			)
+		}
+	case usertweet.Table:
+		switch edge {
+		case usertweet.EdgeUser:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(usertweet.Table, usertweet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usertweet.UserTable, usertweet.UserColumn),
+			)
+		case usertweet.EdgeTweet:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(usertweet.Table, usertweet.FieldID),
+				sqlgraph.To(tweet.Table, tweet.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, usertweet.TweetTable, usertweet.TweetColumn),
+			)
+		}
+	}
+	if step == nil {
+		return nil, fmt.Errorf("unknown edge %q for table %q", edge, s.TableName())
+	}
+	return step, nil
+}
+
 // Count applies the "count" aggregation function on each group.
 func Count() AggregateFunc {
 	return func(s *sql.Selector) string {
+		reportAggregate(s, "count")
 		return sql.Count("*")
 	}
 }
@@ -163,44 +701,88 @@ func Count() AggregateFunc {
 // Max applies the "max" aggregation function on the given field of each group.
 func Max(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Max", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Max(s.C(field))
+		reportAggregate(s, "max_"+field)
+		return sql.Max(c)
 	}
 }
 
 // Mean applies the "mean" aggregation function on the given field of each group.
 func Mean(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Mean", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Avg(s.C(field))
+		reportAggregate(s, "mean_"+field)
+		return sql.Avg(c)
 	}
 }
 
 // Min applies the "min" aggregation function on the given field of each group.
 func Min(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Min", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Min(s.C(field))
+		reportAggregate(s, "min_"+field)
+		return sql.Min(c)
 	}
 }
 
 // Sum applies the "sum" aggregation function on the given field of each group.
 func Sum(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Sum", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Sum(s.C(field))
+		reportAggregate(s, "sum_"+field)
+		return sql.Sum(c)
 	}
 }
 
@@ -317,6 +317,7 @@ func (_q *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = file.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -586,11 +587,11 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -598,6 +599,42 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// FileGroupRow is a typed row that is returned by the FileGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type FileGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FileGroupBy) Rows(ctx context.Context) ([]*FileGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FileGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FileGroupBy) RowsX(ctx context.Context) []*FileGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FileSelect is the builder for selecting fields of File entities.
 type FileSelect struct {
 	*FileQuery
@@ -633,6 +670,9 @@ func (_s *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
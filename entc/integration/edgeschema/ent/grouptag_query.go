@@ -352,6 +352,7 @@ func (_q *GroupTagQuery) GroupBy(field string, fields ...string) *GroupTagGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = grouptag.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +631,11 @@ func (_g *GroupTagGroupBy) sqlScan(ctx context.Context, root *GroupTagQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +643,51 @@ func (_g *GroupTagGroupBy) sqlScan(ctx context.Context, root *GroupTagQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// GroupTagGroupRow is a typed row that is returned by the GroupTagGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type GroupTagGroupRow struct {
+	ID          int     `json:"id,omitempty" sql:"id"`
+	TagID       int     `json:"tag_id,omitempty" sql:"tag_id"`
+	GroupID     int     `json:"group_id,omitempty" sql:"group_id"`
+	Count       int     `json:"count,omitempty" sql:"count"`
+	SumTagID    int     `json:"sum_tag_id,omitempty" sql:"sum_tag_id"`
+	MinTagID    int     `json:"min_tag_id,omitempty" sql:"min_tag_id"`
+	MaxTagID    int     `json:"max_tag_id,omitempty" sql:"max_tag_id"`
+	MeanTagID   float64 `json:"mean_tag_id,omitempty" sql:"mean_tag_id"`
+	SumGroupID  int     `json:"sum_group_id,omitempty" sql:"sum_group_id"`
+	MinGroupID  int     `json:"min_group_id,omitempty" sql:"min_group_id"`
+	MaxGroupID  int     `json:"max_group_id,omitempty" sql:"max_group_id"`
+	MeanGroupID float64 `json:"mean_group_id,omitempty" sql:"mean_group_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *GroupTagGroupBy) Rows(ctx context.Context) ([]*GroupTagGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*GroupTagGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *GroupTagGroupBy) RowsX(ctx context.Context) []*GroupTagGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // GroupTagSelect is the builder for selecting fields of GroupTag entities.
 type GroupTagSelect struct {
 	*GroupTagQuery
@@ -677,6 +723,9 @@ func (_s *GroupTagSelect) sqlScan(ctx context.Context, root *GroupTagQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -341,6 +341,7 @@ func (_q *ProcessQuery) GroupBy(field string, fields ...string) *ProcessGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = process.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -638,11 +639,11 @@ func (_g *ProcessGroupBy) sqlScan(ctx context.Context, root *ProcessQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -650,6 +651,41 @@ func (_g *ProcessGroupBy) sqlScan(ctx context.Context, root *ProcessQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// ProcessGroupRow is a typed row that is returned by the ProcessGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ProcessGroupRow struct {
+	ID    int `json:"id,omitempty" sql:"id"`
+	Count int `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ProcessGroupBy) Rows(ctx context.Context) ([]*ProcessGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ProcessGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ProcessGroupBy) RowsX(ctx context.Context) []*ProcessGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ProcessSelect is the builder for selecting fields of Process entities.
 type ProcessSelect struct {
 	*ProcessQuery
@@ -685,6 +721,9 @@ func (_s *ProcessSelect) sqlScan(ctx context.Context, root *ProcessQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
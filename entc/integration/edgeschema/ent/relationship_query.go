@@ -315,6 +315,7 @@ func (_q *RelationshipQuery) GroupBy(field string, fields ...string) *Relationsh
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = relationship.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -633,11 +634,11 @@ func (_g *RelationshipGroupBy) sqlScan(ctx context.Context, root *RelationshipQu
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -645,6 +646,60 @@ func (_g *RelationshipGroupBy) sqlScan(ctx context.Context, root *RelationshipQu
 	return sql.ScanSlice(rows, v)
 }
 
+// RelationshipGroupRow is a typed row that is returned by the RelationshipGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type RelationshipGroupRow struct {
+	Weight         int     `json:"weight,omitempty" sql:"weight"`
+	UserID         int     `json:"user_id,omitempty" sql:"user_id"`
+	RelativeID     int     `json:"relative_id,omitempty" sql:"relative_id"`
+	InfoID         int     `json:"info_id,omitempty" sql:"info_id"`
+	Count          int     `json:"count,omitempty" sql:"count"`
+	SumWeight      int     `json:"sum_weight,omitempty" sql:"sum_weight"`
+	MinWeight      int     `json:"min_weight,omitempty" sql:"min_weight"`
+	MaxWeight      int     `json:"max_weight,omitempty" sql:"max_weight"`
+	MeanWeight     float64 `json:"mean_weight,omitempty" sql:"mean_weight"`
+	SumUserID      int     `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID      int     `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID      int     `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID     float64 `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumRelativeID  int     `json:"sum_relative_id,omitempty" sql:"sum_relative_id"`
+	MinRelativeID  int     `json:"min_relative_id,omitempty" sql:"min_relative_id"`
+	MaxRelativeID  int     `json:"max_relative_id,omitempty" sql:"max_relative_id"`
+	MeanRelativeID float64 `json:"mean_relative_id,omitempty" sql:"mean_relative_id"`
+	SumInfoID      int     `json:"sum_info_id,omitempty" sql:"sum_info_id"`
+	MinInfoID      int     `json:"min_info_id,omitempty" sql:"min_info_id"`
+	MaxInfoID      int     `json:"max_info_id,omitempty" sql:"max_info_id"`
+	MeanInfoID     float64 `json:"mean_info_id,omitempty" sql:"mean_info_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *RelationshipGroupBy) Rows(ctx context.Context) ([]*RelationshipGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*RelationshipGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *RelationshipGroupBy) RowsX(ctx context.Context) []*RelationshipGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // RelationshipSelect is the builder for selecting fields of Relationship entities.
 type RelationshipSelect struct {
 	*RelationshipQuery
@@ -680,6 +735,9 @@ func (_s *RelationshipSelect) sqlScan(ctx context.Context, root *RelationshipQue
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
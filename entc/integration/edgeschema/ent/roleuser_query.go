@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -279,6 +280,7 @@ func (_q *RoleUserQuery) GroupBy(field string, fields ...string) *RoleUserGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = roleuser.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -552,11 +554,11 @@ func (_g *RoleUserGroupBy) sqlScan(ctx context.Context, root *RoleUserQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -564,6 +566,53 @@ func (_g *RoleUserGroupBy) sqlScan(ctx context.Context, root *RoleUserQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// RoleUserGroupRow is a typed row that is returned by the RoleUserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type RoleUserGroupRow struct {
+	CreatedAt  time.Time `json:"created_at,omitempty" sql:"created_at"`
+	RoleID     int       `json:"role_id,omitempty" sql:"role_id"`
+	UserID     int       `json:"user_id,omitempty" sql:"user_id"`
+	Bucket     time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count      int       `json:"count,omitempty" sql:"count"`
+	SumRoleID  int       `json:"sum_role_id,omitempty" sql:"sum_role_id"`
+	MinRoleID  int       `json:"min_role_id,omitempty" sql:"min_role_id"`
+	MaxRoleID  int       `json:"max_role_id,omitempty" sql:"max_role_id"`
+	MeanRoleID float64   `json:"mean_role_id,omitempty" sql:"mean_role_id"`
+	SumUserID  int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID  int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID  int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *RoleUserGroupBy) Rows(ctx context.Context) ([]*RoleUserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*RoleUserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *RoleUserGroupBy) RowsX(ctx context.Context) []*RoleUserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // RoleUserSelect is the builder for selecting fields of RoleUser entities.
 type RoleUserSelect struct {
 	*RoleUserQuery
@@ -599,6 +648,9 @@ func (_s *RoleUserSelect) sqlScan(ctx context.Context, root *RoleUserQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
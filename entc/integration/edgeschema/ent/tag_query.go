@@ -425,6 +425,7 @@ func (_q *TagQuery) GroupBy(field string, fields ...string) *TagGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = tag.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -839,11 +840,11 @@ func (_g *TagGroupBy) sqlScan(ctx context.Context, root *TagQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -851,6 +852,42 @@ func (_g *TagGroupBy) sqlScan(ctx context.Context, root *TagQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// TagGroupRow is a typed row that is returned by the TagGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type TagGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Value string `json:"value,omitempty" sql:"value"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TagGroupBy) Rows(ctx context.Context) ([]*TagGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TagGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TagGroupBy) RowsX(ctx context.Context) []*TagGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TagSelect is the builder for selecting fields of Tag entities.
 type TagSelect struct {
 	*TagQuery
@@ -886,6 +923,9 @@ func (_s *TagSelect) sqlScan(ctx context.Context, root *TagQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -496,6 +496,7 @@ func (_q *TweetQuery) GroupBy(field string, fields ...string) *TweetGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = tweet.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -1017,11 +1018,11 @@ func (_g *TweetGroupBy) sqlScan(ctx context.Context, root *TweetQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -1029,6 +1030,42 @@ func (_g *TweetGroupBy) sqlScan(ctx context.Context, root *TweetQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// TweetGroupRow is a typed row that is returned by the TweetGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type TweetGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Text  string `json:"text,omitempty" sql:"text"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TweetGroupBy) Rows(ctx context.Context) ([]*TweetGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TweetGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TweetGroupBy) RowsX(ctx context.Context) []*TweetGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TweetSelect is the builder for selecting fields of Tweet entities.
 type TweetSelect struct {
 	*TweetQuery
@@ -1064,6 +1101,9 @@ func (_s *TweetSelect) sqlScan(ctx context.Context, root *TweetQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
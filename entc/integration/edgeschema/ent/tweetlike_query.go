@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -280,6 +281,7 @@ func (_q *TweetLikeQuery) GroupBy(field string, fields ...string) *TweetLikeGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = tweetlike.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -559,11 +561,11 @@ func (_g *TweetLikeGroupBy) sqlScan(ctx context.Context, root *TweetLikeQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -571,6 +573,53 @@ func (_g *TweetLikeGroupBy) sqlScan(ctx context.Context, root *TweetLikeQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// TweetLikeGroupRow is a typed row that is returned by the TweetLikeGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type TweetLikeGroupRow struct {
+	LikedAt     time.Time `json:"liked_at,omitempty" sql:"liked_at"`
+	UserID      int       `json:"user_id,omitempty" sql:"user_id"`
+	TweetID     int       `json:"tweet_id,omitempty" sql:"tweet_id"`
+	Bucket      time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count       int       `json:"count,omitempty" sql:"count"`
+	SumUserID   int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID   int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID   int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID  float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumTweetID  int       `json:"sum_tweet_id,omitempty" sql:"sum_tweet_id"`
+	MinTweetID  int       `json:"min_tweet_id,omitempty" sql:"min_tweet_id"`
+	MaxTweetID  int       `json:"max_tweet_id,omitempty" sql:"max_tweet_id"`
+	MeanTweetID float64   `json:"mean_tweet_id,omitempty" sql:"mean_tweet_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TweetLikeGroupBy) Rows(ctx context.Context) ([]*TweetLikeGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TweetLikeGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TweetLikeGroupBy) RowsX(ctx context.Context) []*TweetLikeGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TweetLikeSelect is the builder for selecting fields of TweetLike entities.
 type TweetLikeSelect struct {
 	*TweetLikeQuery
@@ -606,6 +655,9 @@ func (_s *TweetLikeSelect) sqlScan(ctx context.Context, root *TweetLikeQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
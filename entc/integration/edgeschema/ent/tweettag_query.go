@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -353,6 +354,7 @@ func (_q *TweetTagQuery) GroupBy(field string, fields ...string) *TweetTagGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = tweettag.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -631,11 +633,11 @@ func (_g *TweetTagGroupBy) sqlScan(ctx context.Context, root *TweetTagQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -643,6 +645,54 @@ func (_g *TweetTagGroupBy) sqlScan(ctx context.Context, root *TweetTagQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// TweetTagGroupRow is a typed row that is returned by the TweetTagGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type TweetTagGroupRow struct {
+	ID          uuid.UUID `json:"id,omitempty" sql:"id"`
+	AddedAt     time.Time `json:"added_at,omitempty" sql:"added_at"`
+	TagID       int       `json:"tag_id,omitempty" sql:"tag_id"`
+	TweetID     int       `json:"tweet_id,omitempty" sql:"tweet_id"`
+	Bucket      time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count       int       `json:"count,omitempty" sql:"count"`
+	SumTagID    int       `json:"sum_tag_id,omitempty" sql:"sum_tag_id"`
+	MinTagID    int       `json:"min_tag_id,omitempty" sql:"min_tag_id"`
+	MaxTagID    int       `json:"max_tag_id,omitempty" sql:"max_tag_id"`
+	MeanTagID   float64   `json:"mean_tag_id,omitempty" sql:"mean_tag_id"`
+	SumTweetID  int       `json:"sum_tweet_id,omitempty" sql:"sum_tweet_id"`
+	MinTweetID  int       `json:"min_tweet_id,omitempty" sql:"min_tweet_id"`
+	MaxTweetID  int       `json:"max_tweet_id,omitempty" sql:"max_tweet_id"`
+	MeanTweetID float64   `json:"mean_tweet_id,omitempty" sql:"mean_tweet_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TweetTagGroupBy) Rows(ctx context.Context) ([]*TweetTagGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TweetTagGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TweetTagGroupBy) RowsX(ctx context.Context) []*TweetTagGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TweetTagSelect is the builder for selecting fields of TweetTag entities.
 type TweetTagSelect struct {
 	*TweetTagQuery
@@ -678,6 +728,9 @@ func (_s *TweetTagSelect) sqlScan(ctx context.Context, root *TweetTagQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
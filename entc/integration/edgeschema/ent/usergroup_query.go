@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -352,6 +353,7 @@ func (_q *UserGroupQuery) GroupBy(field string, fields ...string) *UserGroupGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = usergroup.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +632,11 @@ func (_g *UserGroupGroupBy) sqlScan(ctx context.Context, root *UserGroupQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +644,54 @@ func (_g *UserGroupGroupBy) sqlScan(ctx context.Context, root *UserGroupQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupGroupRow is a typed row that is returned by the UserGroupGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type UserGroupGroupRow struct {
+	ID          int       `json:"id,omitempty" sql:"id"`
+	JoinedAt    time.Time `json:"joined_at,omitempty" sql:"joined_at"`
+	UserID      int       `json:"user_id,omitempty" sql:"user_id"`
+	GroupID     int       `json:"group_id,omitempty" sql:"group_id"`
+	Bucket      time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count       int       `json:"count,omitempty" sql:"count"`
+	SumUserID   int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID   int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID   int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID  float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumGroupID  int       `json:"sum_group_id,omitempty" sql:"sum_group_id"`
+	MinGroupID  int       `json:"min_group_id,omitempty" sql:"min_group_id"`
+	MaxGroupID  int       `json:"max_group_id,omitempty" sql:"max_group_id"`
+	MeanGroupID float64   `json:"mean_group_id,omitempty" sql:"mean_group_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupGroupBy) Rows(ctx context.Context) ([]*UserGroupGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupGroupBy) RowsX(ctx context.Context) []*UserGroupGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserGroupSelect is the builder for selecting fields of UserGroup entities.
 type UserGroupSelect struct {
 	*UserGroupQuery
@@ -677,6 +727,9 @@ func (_s *UserGroupSelect) sqlScan(ctx context.Context, root *UserGroupQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -352,6 +353,7 @@ func (_q *UserTweetQuery) GroupBy(field string, fields ...string) *UserTweetGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = usertweet.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +632,11 @@ func (_g *UserTweetGroupBy) sqlScan(ctx context.Context, root *UserTweetQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +644,54 @@ func (_g *UserTweetGroupBy) sqlScan(ctx context.Context, root *UserTweetQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// UserTweetGroupRow is a typed row that is returned by the UserTweetGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type UserTweetGroupRow struct {
+	ID          int       `json:"id,omitempty" sql:"id"`
+	CreatedAt   time.Time `json:"created_at,omitempty" sql:"created_at"`
+	UserID      int       `json:"user_id,omitempty" sql:"user_id"`
+	TweetID     int       `json:"tweet_id,omitempty" sql:"tweet_id"`
+	Bucket      time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count       int       `json:"count,omitempty" sql:"count"`
+	SumUserID   int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID   int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID   int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID  float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumTweetID  int       `json:"sum_tweet_id,omitempty" sql:"sum_tweet_id"`
+	MinTweetID  int       `json:"min_tweet_id,omitempty" sql:"min_tweet_id"`
+	MaxTweetID  int       `json:"max_tweet_id,omitempty" sql:"max_tweet_id"`
+	MeanTweetID float64   `json:"mean_tweet_id,omitempty" sql:"mean_tweet_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserTweetGroupBy) Rows(ctx context.Context) ([]*UserTweetGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserTweetGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserTweetGroupBy) RowsX(ctx context.Context) []*UserTweetGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserTweetSelect is the builder for selecting fields of UserTweet entities.
 type UserTweetSelect struct {
 	*UserTweetQuery
@@ -677,6 +727,9 @@ func (_s *UserTweetSelect) sqlScan(ctx context.Context, root *UserTweetQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -0,0 +1,2923 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent/entc/integration/ent/api"
+	"entgo.io/ent/entc/integration/ent/builder"
+	"entgo.io/ent/entc/integration/ent/card"
+	"entgo.io/ent/entc/integration/ent/comment"
+	"entgo.io/ent/entc/integration/ent/exvaluescan"
+	"entgo.io/ent/entc/integration/ent/fieldtype"
+	"entgo.io/ent/entc/integration/ent/file"
+	"entgo.io/ent/entc/integration/ent/filetype"
+	"entgo.io/ent/entc/integration/ent/goods"
+	"entgo.io/ent/entc/integration/ent/group"
+	"entgo.io/ent/entc/integration/ent/groupinfo"
+	"entgo.io/ent/entc/integration/ent/item"
+	"entgo.io/ent/entc/integration/ent/license"
+	"entgo.io/ent/entc/integration/ent/node"
+	"entgo.io/ent/entc/integration/ent/pc"
+	"entgo.io/ent/entc/integration/ent/pet"
+	"entgo.io/ent/entc/integration/ent/spec"
+	enttask "entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/entc/integration/ent/user"
+)
+
+// Clone returns a builder for cloning the given Api entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *APIClient) Clone(ctx context.Context, node *Api, opts ...func(*ApiCloner)) *ApiCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &ApiCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// ApiCloner is the builder for cloning Api entities and their selected edges.
+type ApiCloner struct {
+	config
+	node     *Api
+	mutators []func(*APICreate, *Api)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Api entity it was copied from.
+func (c *ApiCloner) Mutate(fns ...func(*APICreate, *Api)) *ApiCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Api entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *ApiCloner) Save(ctx context.Context) (*Api, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *ApiCloner) SaveX(ctx context.Context) *Api {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Api entity with its selected edges, and clones them using the given config.
+func (c *ApiCloner) save(ctx context.Context, cfg config) (*Api, error) {
+	query := NewAPIClient(cfg).Query().Where(api.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Api{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *ApiCloner) load(query *APIQuery) {
+}
+
+// clone creates a copy of the given Api entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *ApiCloner) clone(ctx context.Context, cfg config, nodes []*Api, set func(int, *APICreate)) ([]*Api, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewAPIClient(cfg)
+	builders := make([]*APICreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Builder entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *BuilderClient) Clone(ctx context.Context, node *Builder, opts ...func(*BuilderCloner)) *BuilderCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &BuilderCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// BuilderCloner is the builder for cloning Builder entities and their selected edges.
+type BuilderCloner struct {
+	config
+	node     *Builder
+	mutators []func(*BuilderCreate, *Builder)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Builder entity it was copied from.
+func (c *BuilderCloner) Mutate(fns ...func(*BuilderCreate, *Builder)) *BuilderCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Builder entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *BuilderCloner) Save(ctx context.Context) (*Builder, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *BuilderCloner) SaveX(ctx context.Context) *Builder {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Builder entity with its selected edges, and clones them using the given config.
+func (c *BuilderCloner) save(ctx context.Context, cfg config) (*Builder, error) {
+	query := NewBuilderClient(cfg).Query().Where(builder.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Builder{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *BuilderCloner) load(query *BuilderQuery) {
+}
+
+// clone creates a copy of the given Builder entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *BuilderCloner) clone(ctx context.Context, cfg config, nodes []*Builder, set func(int, *BuilderCreate)) ([]*Builder, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewBuilderClient(cfg)
+	builders := make([]*BuilderCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Card entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *CardClient) Clone(ctx context.Context, node *Card, opts ...func(*CardCloner)) *CardCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &CardCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// CardCloner is the builder for cloning Card entities and their selected edges.
+type CardCloner struct {
+	config
+	node     *Card
+	mutators []func(*CardCreate, *Card)
+	withSpec bool
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Card entity it was copied from.
+func (c *CardCloner) Mutate(fns ...func(*CardCreate, *Card)) *CardCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithSpec tells the cloner to link the clone to the same Spec entities
+// of the "spec" edge. The Spec entities themselves are not cloned.
+func (c *CardCloner) WithSpec() *CardCloner {
+	c.withSpec = true
+	return c
+}
+
+// Save clones the Card entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *CardCloner) Save(ctx context.Context) (*Card, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *CardCloner) SaveX(ctx context.Context) *Card {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Card entity with its selected edges, and clones them using the given config.
+func (c *CardCloner) save(ctx context.Context, cfg config) (*Card, error) {
+	query := NewCardClient(cfg).Query().Where(card.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Card{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *CardCloner) load(query *CardQuery) {
+	query.withFKs = true
+	if c.withSpec {
+		query.WithSpec(func(q *SpecQuery) {
+			q.Select(spec.FieldID)
+		})
+	}
+}
+
+// clone creates a copy of the given Card entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *CardCloner) clone(ctx context.Context, cfg config, nodes []*Card, set func(int, *CardCreate)) ([]*Card, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewCardClient(cfg)
+	builders := make([]*CardCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetCreateTime(n.CreateTime)
+		b.SetUpdateTime(n.UpdateTime)
+		b.SetBalance(n.Balance)
+		b.SetNumber(n.Number)
+		if !isZeroValue(n.Name) {
+			b.SetName(n.Name)
+		}
+		if c.withSpec {
+			for _, e := range n.Edges.Spec {
+				b.AddSpecIDs(e.ID)
+			}
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Comment entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *CommentClient) Clone(ctx context.Context, node *Comment, opts ...func(*CommentCloner)) *CommentCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &CommentCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// CommentCloner is the builder for cloning Comment entities and their selected edges.
+type CommentCloner struct {
+	config
+	node     *Comment
+	mutators []func(*CommentCreate, *Comment)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Comment entity it was copied from.
+func (c *CommentCloner) Mutate(fns ...func(*CommentCreate, *Comment)) *CommentCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Comment entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *CommentCloner) Save(ctx context.Context) (*Comment, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *CommentCloner) SaveX(ctx context.Context) *Comment {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Comment entity with its selected edges, and clones them using the given config.
+func (c *CommentCloner) save(ctx context.Context, cfg config) (*Comment, error) {
+	query := NewCommentClient(cfg).Query().Where(comment.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Comment{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *CommentCloner) load(query *CommentQuery) {
+}
+
+// clone creates a copy of the given Comment entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *CommentCloner) clone(ctx context.Context, cfg config, nodes []*Comment, set func(int, *CommentCreate)) ([]*Comment, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewCommentClient(cfg)
+	builders := make([]*CommentCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetUniqueInt(n.UniqueInt)
+		b.SetUniqueFloat(n.UniqueFloat)
+		if n.NillableInt != nil {
+			b.SetNillableInt(*n.NillableInt)
+		}
+		if !isZeroValue(n.Table) {
+			b.SetTable(n.Table)
+		}
+		if !isZeroValue(n.Dir) {
+			b.SetDir(n.Dir)
+		}
+		if !isZeroValue(n.Client) {
+			b.SetClient(n.Client)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given ExValueScan entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *ExValueScanClient) Clone(ctx context.Context, node *ExValueScan, opts ...func(*ExValueScanCloner)) *ExValueScanCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &ExValueScanCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// ExValueScanCloner is the builder for cloning ExValueScan entities and their selected edges.
+type ExValueScanCloner struct {
+	config
+	node     *ExValueScan
+	mutators []func(*ExValueScanCreate, *ExValueScan)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the ExValueScan entity it was copied from.
+func (c *ExValueScanCloner) Mutate(fns ...func(*ExValueScanCreate, *ExValueScan)) *ExValueScanCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the ExValueScan entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *ExValueScanCloner) Save(ctx context.Context) (*ExValueScan, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *ExValueScanCloner) SaveX(ctx context.Context) *ExValueScan {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the ExValueScan entity with its selected edges, and clones them using the given config.
+func (c *ExValueScanCloner) save(ctx context.Context, cfg config) (*ExValueScan, error) {
+	query := NewExValueScanClient(cfg).Query().Where(exvaluescan.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*ExValueScan{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *ExValueScanCloner) load(query *ExValueScanQuery) {
+}
+
+// clone creates a copy of the given ExValueScan entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *ExValueScanCloner) clone(ctx context.Context, cfg config, nodes []*ExValueScan, set func(int, *ExValueScanCreate)) ([]*ExValueScan, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewExValueScanClient(cfg)
+	builders := make([]*ExValueScanCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetBinary(n.Binary)
+		b.SetBinaryBytes(n.BinaryBytes)
+		if !isZeroValue(n.BinaryOptional) {
+			b.SetBinaryOptional(n.BinaryOptional)
+		}
+		b.SetText(n.Text)
+		if !isZeroValue(n.TextOptional) {
+			b.SetTextOptional(n.TextOptional)
+		}
+		b.SetBase64(n.Base64)
+		b.SetCustom(n.Custom)
+		if !isZeroValue(n.CustomOptional) {
+			b.SetCustomOptional(n.CustomOptional)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given FieldType entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *FieldTypeClient) Clone(ctx context.Context, node *FieldType, opts ...func(*FieldTypeCloner)) *FieldTypeCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &FieldTypeCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// FieldTypeCloner is the builder for cloning FieldType entities and their selected edges.
+type FieldTypeCloner struct {
+	config
+	node     *FieldType
+	mutators []func(*FieldTypeCreate, *FieldType)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the FieldType entity it was copied from.
+func (c *FieldTypeCloner) Mutate(fns ...func(*FieldTypeCreate, *FieldType)) *FieldTypeCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the FieldType entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *FieldTypeCloner) Save(ctx context.Context) (*FieldType, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *FieldTypeCloner) SaveX(ctx context.Context) *FieldType {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the FieldType entity with its selected edges, and clones them using the given config.
+func (c *FieldTypeCloner) save(ctx context.Context, cfg config) (*FieldType, error) {
+	query := NewFieldTypeClient(cfg).Query().Where(fieldtype.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*FieldType{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *FieldTypeCloner) load(query *FieldTypeQuery) {
+	query.withFKs = true
+}
+
+// clone creates a copy of the given FieldType entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *FieldTypeCloner) clone(ctx context.Context, cfg config, nodes []*FieldType, set func(int, *FieldTypeCreate)) ([]*FieldType, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewFieldTypeClient(cfg)
+	builders := make([]*FieldTypeCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetInt(n.Int)
+		b.SetInt8(n.Int8)
+		b.SetInt16(n.Int16)
+		b.SetInt32(n.Int32)
+		b.SetInt64(n.Int64)
+		if !isZeroValue(n.OptionalInt) {
+			b.SetOptionalInt(n.OptionalInt)
+		}
+		if !isZeroValue(n.OptionalInt8) {
+			b.SetOptionalInt8(n.OptionalInt8)
+		}
+		if !isZeroValue(n.OptionalInt16) {
+			b.SetOptionalInt16(n.OptionalInt16)
+		}
+		if !isZeroValue(n.OptionalInt32) {
+			b.SetOptionalInt32(n.OptionalInt32)
+		}
+		if !isZeroValue(n.OptionalInt64) {
+			b.SetOptionalInt64(n.OptionalInt64)
+		}
+		if n.NillableInt != nil {
+			b.SetNillableInt(*n.NillableInt)
+		}
+		if n.NillableInt8 != nil {
+			b.SetNillableInt8(*n.NillableInt8)
+		}
+		if n.NillableInt16 != nil {
+			b.SetNillableInt16(*n.NillableInt16)
+		}
+		if n.NillableInt32 != nil {
+			b.SetNillableInt32(*n.NillableInt32)
+		}
+		if n.NillableInt64 != nil {
+			b.SetNillableInt64(*n.NillableInt64)
+		}
+		if !isZeroValue(n.ValidateOptionalInt32) {
+			b.SetValidateOptionalInt32(n.ValidateOptionalInt32)
+		}
+		if !isZeroValue(n.OptionalUint) {
+			b.SetOptionalUint(n.OptionalUint)
+		}
+		if !isZeroValue(n.OptionalUint8) {
+			b.SetOptionalUint8(n.OptionalUint8)
+		}
+		if !isZeroValue(n.OptionalUint16) {
+			b.SetOptionalUint16(n.OptionalUint16)
+		}
+		if !isZeroValue(n.OptionalUint32) {
+			b.SetOptionalUint32(n.OptionalUint32)
+		}
+		if !isZeroValue(n.OptionalUint64) {
+			b.SetOptionalUint64(n.OptionalUint64)
+		}
+		if !isZeroValue(n.State) {
+			b.SetState(n.State)
+		}
+		if !isZeroValue(n.OptionalFloat) {
+			b.SetOptionalFloat(n.OptionalFloat)
+		}
+		if !isZeroValue(n.OptionalFloat32) {
+			b.SetOptionalFloat32(n.OptionalFloat32)
+		}
+		if !isZeroValue(n.Text) {
+			b.SetText(n.Text)
+		}
+		if !isZeroValue(n.Datetime) {
+			b.SetDatetime(n.Datetime)
+		}
+		if !isZeroValue(n.Decimal) {
+			b.SetDecimal(n.Decimal)
+		}
+		b.SetLinkOther(n.LinkOther)
+		b.SetLinkOtherFunc(n.LinkOtherFunc)
+		if !isZeroValue(n.MAC) {
+			b.SetMAC(n.MAC)
+		}
+		if !isZeroValue(n.StringArray) {
+			b.SetStringArray(n.StringArray)
+		}
+		if !isZeroValue(n.Password) {
+			b.SetPassword(n.Password)
+		}
+		if n.StringScanner != nil {
+			b.SetStringScanner(*n.StringScanner)
+		}
+		if !isZeroValue(n.Duration) {
+			b.SetDuration(n.Duration)
+		}
+		b.SetDir(n.Dir)
+		if n.Ndir != nil {
+			b.SetNdir(*n.Ndir)
+		}
+		b.SetStr(n.Str)
+		if n.NullStr != nil {
+			b.SetNullStr(n.NullStr)
+		}
+		if !isZeroValue(n.Link) {
+			b.SetLink(n.Link)
+		}
+		if n.NullLink != nil {
+			b.SetNullLink(n.NullLink)
+		}
+		if !isZeroValue(n.Active) {
+			b.SetActive(n.Active)
+		}
+		if n.NullActive != nil {
+			b.SetNullActive(*n.NullActive)
+		}
+		if n.Deleted != nil {
+			b.SetDeleted(n.Deleted)
+		}
+		b.SetDeletedAt(n.DeletedAt)
+		if !isZeroValue(n.RawData) {
+			b.SetRawData(n.RawData)
+		}
+		if !isZeroValue(n.Sensitive) {
+			b.SetSensitive(n.Sensitive)
+		}
+		b.SetIP(n.IP)
+		if !isZeroValue(n.NullInt64) {
+			b.SetNullInt64(n.NullInt64)
+		}
+		if !isZeroValue(n.SchemaInt) {
+			b.SetSchemaInt(n.SchemaInt)
+		}
+		if !isZeroValue(n.SchemaInt8) {
+			b.SetSchemaInt8(n.SchemaInt8)
+		}
+		if !isZeroValue(n.SchemaInt64) {
+			b.SetSchemaInt64(n.SchemaInt64)
+		}
+		if !isZeroValue(n.SchemaFloat) {
+			b.SetSchemaFloat(n.SchemaFloat)
+		}
+		if !isZeroValue(n.SchemaFloat32) {
+			b.SetSchemaFloat32(n.SchemaFloat32)
+		}
+		if !isZeroValue(n.NullFloat) {
+			b.SetNullFloat(n.NullFloat)
+		}
+		b.SetRole(n.Role)
+		if !isZeroValue(n.Priority) {
+			b.SetPriority(n.Priority)
+		}
+		if !isZeroValue(n.OptionalUUID) {
+			b.SetOptionalUUID(n.OptionalUUID)
+		}
+		if n.NillableUUID != nil {
+			b.SetNillableUUID(*n.NillableUUID)
+		}
+		if !isZeroValue(n.Strings) {
+			b.SetStrings(n.Strings)
+		}
+		b.SetPair(n.Pair)
+		if n.NilPair != nil {
+			b.SetNilPair(n.NilPair)
+		}
+		b.SetVstring(n.Vstring)
+		b.SetTriple(n.Triple)
+		if !isZeroValue(n.BigInt) {
+			b.SetBigInt(n.BigInt)
+		}
+		if !isZeroValue(n.PasswordOther) {
+			b.SetPasswordOther(n.PasswordOther)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given File entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *FileClient) Clone(ctx context.Context, node *File, opts ...func(*FileCloner)) *FileCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &FileCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// FileCloner is the builder for cloning File entities and their selected edges.
+type FileCloner struct {
+	config
+	node      *File
+	mutators  []func(*FileCreate, *File)
+	withField *FieldTypeCloner
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the File entity it was copied from.
+func (c *FileCloner) Mutate(fns ...func(*FileCreate, *File)) *FileCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithField tells the cloner to clone the FieldType entities of the "field" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *FileCloner) WithField(opts ...func(*FieldTypeCloner)) *FileCloner {
+	cloner := &FieldTypeCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withField = cloner
+	return c
+}
+
+// Save clones the File entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *FileCloner) Save(ctx context.Context) (*File, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *FileCloner) SaveX(ctx context.Context) *File {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the File entity with its selected edges, and clones them using the given config.
+func (c *FileCloner) save(ctx context.Context, cfg config) (*File, error) {
+	query := NewFileClient(cfg).Query().Where(file.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*File{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *FileCloner) load(query *FileQuery) {
+	query.withFKs = true
+	if c.withField != nil {
+		query.WithField(c.withField.load)
+	}
+}
+
+// clone creates a copy of the given File entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *FileCloner) clone(ctx context.Context, cfg config, nodes []*File, set func(int, *FileCreate)) ([]*File, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewFileClient(cfg)
+	builders := make([]*FileCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if !isZeroValue(n.SetID) {
+			b.SetSetID(n.SetID)
+		}
+		b.SetSize(n.Size)
+		b.SetName(n.Name)
+		if n.User != nil {
+			b.SetUser(*n.User)
+		}
+		if !isZeroValue(n.Group) {
+			b.SetGroup(n.Group)
+		}
+		if !isZeroValue(n.Op) {
+			b.SetOp(n.Op)
+		}
+		if !isZeroValue(n.FieldID) {
+			b.SetFieldID(n.FieldID)
+		}
+		if !isZeroValue(n.CreateTime) {
+			b.SetCreateTime(n.CreateTime)
+		}
+		if n.file_type_files != nil {
+			b.SetTypeID(*n.file_type_files)
+		}
+		if n.user_files != nil {
+			b.SetOwnerID(*n.user_files)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	if c.withField != nil {
+		var (
+			children []*FieldType
+			parents  []int
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Field {
+				children = append(children, e)
+				parents = append(parents, i)
+			}
+		}
+		clones, err := c.withField.clone(ctx, cfg, children, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i, e := range clones {
+			builders[parents[i]].AddFieldIDs(e.ID)
+		}
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given FileType entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *FileTypeClient) Clone(ctx context.Context, node *FileType, opts ...func(*FileTypeCloner)) *FileTypeCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &FileTypeCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// FileTypeCloner is the builder for cloning FileType entities and their selected edges.
+type FileTypeCloner struct {
+	config
+	node      *FileType
+	mutators  []func(*FileTypeCreate, *FileType)
+	withFiles *FileCloner
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the FileType entity it was copied from.
+func (c *FileTypeCloner) Mutate(fns ...func(*FileTypeCreate, *FileType)) *FileTypeCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithFiles tells the cloner to clone the File entities of the "files" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *FileTypeCloner) WithFiles(opts ...func(*FileCloner)) *FileTypeCloner {
+	cloner := &FileCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withFiles = cloner
+	return c
+}
+
+// Save clones the FileType entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *FileTypeCloner) Save(ctx context.Context) (*FileType, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *FileTypeCloner) SaveX(ctx context.Context) *FileType {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the FileType entity with its selected edges, and clones them using the given config.
+func (c *FileTypeCloner) save(ctx context.Context, cfg config) (*FileType, error) {
+	query := NewFileTypeClient(cfg).Query().Where(filetype.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*FileType{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *FileTypeCloner) load(query *FileTypeQuery) {
+	if c.withFiles != nil {
+		query.WithFiles(c.withFiles.load)
+	}
+}
+
+// clone creates a copy of the given FileType entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *FileTypeCloner) clone(ctx context.Context, cfg config, nodes []*FileType, set func(int, *FileTypeCreate)) ([]*FileType, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewFileTypeClient(cfg)
+	builders := make([]*FileTypeCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetName(n.Name)
+		b.SetType(n.Type)
+		b.SetState(n.State)
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.withFiles != nil {
+		var (
+			children []*File
+			parents  []*FileType
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Files {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withFiles.clone(ctx, cfg, children, func(i int, b *FileCreate) {
+			b.SetTypeID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Goods entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *GoodsClient) Clone(ctx context.Context, node *Goods, opts ...func(*GoodsCloner)) *GoodsCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &GoodsCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// GoodsCloner is the builder for cloning Goods entities and their selected edges.
+type GoodsCloner struct {
+	config
+	node     *Goods
+	mutators []func(*GoodsCreate, *Goods)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Goods entity it was copied from.
+func (c *GoodsCloner) Mutate(fns ...func(*GoodsCreate, *Goods)) *GoodsCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Goods entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *GoodsCloner) Save(ctx context.Context) (*Goods, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *GoodsCloner) SaveX(ctx context.Context) *Goods {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Goods entity with its selected edges, and clones them using the given config.
+func (c *GoodsCloner) save(ctx context.Context, cfg config) (*Goods, error) {
+	query := NewGoodsClient(cfg).Query().Where(goods.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Goods{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *GoodsCloner) load(query *GoodsQuery) {
+}
+
+// clone creates a copy of the given Goods entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *GoodsCloner) clone(ctx context.Context, cfg config, nodes []*Goods, set func(int, *GoodsCreate)) ([]*Goods, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewGoodsClient(cfg)
+	builders := make([]*GoodsCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Group entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *GroupClient) Clone(ctx context.Context, node *Group, opts ...func(*GroupCloner)) *GroupCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &GroupCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// GroupCloner is the builder for cloning Group entities and their selected edges.
+type GroupCloner struct {
+	config
+	node        *Group
+	mutators    []func(*GroupCreate, *Group)
+	withFiles   *FileCloner
+	withBlocked *UserCloner
+	withUsers   bool
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Group entity it was copied from.
+func (c *GroupCloner) Mutate(fns ...func(*GroupCreate, *Group)) *GroupCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithFiles tells the cloner to clone the File entities of the "files" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *GroupCloner) WithFiles(opts ...func(*FileCloner)) *GroupCloner {
+	cloner := &FileCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withFiles = cloner
+	return c
+}
+
+// WithBlocked tells the cloner to clone the User entities of the "blocked" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *GroupCloner) WithBlocked(opts ...func(*UserCloner)) *GroupCloner {
+	cloner := &UserCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withBlocked = cloner
+	return c
+}
+
+// WithUsers tells the cloner to link the clone to the same User entities
+// of the "users" edge. The User entities themselves are not cloned.
+func (c *GroupCloner) WithUsers() *GroupCloner {
+	c.withUsers = true
+	return c
+}
+
+// Save clones the Group entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *GroupCloner) Save(ctx context.Context) (*Group, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *GroupCloner) SaveX(ctx context.Context) *Group {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Group entity with its selected edges, and clones them using the given config.
+func (c *GroupCloner) save(ctx context.Context, cfg config) (*Group, error) {
+	query := NewGroupClient(cfg).Query().Where(group.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Group{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *GroupCloner) load(query *GroupQuery) {
+	query.withFKs = true
+	if c.withFiles != nil {
+		query.WithFiles(c.withFiles.load)
+	}
+	if c.withBlocked != nil {
+		query.WithBlocked(c.withBlocked.load)
+	}
+	if c.withUsers {
+		query.WithUsers(func(q *UserQuery) {
+			q.Select(user.FieldID)
+		})
+	}
+}
+
+// clone creates a copy of the given Group entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *GroupCloner) clone(ctx context.Context, cfg config, nodes []*Group, set func(int, *GroupCreate)) ([]*Group, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewGroupClient(cfg)
+	builders := make([]*GroupCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetActive(n.Active)
+		b.SetExpire(n.Expire)
+		if n.Type != nil {
+			b.SetType(*n.Type)
+		}
+		b.SetMaxUsers(n.MaxUsers)
+		b.SetName(n.Name)
+		if n.group_info != nil {
+			b.SetInfoID(*n.group_info)
+		}
+		if c.withUsers {
+			for _, e := range n.Edges.Users {
+				b.AddUserIDs(e.ID)
+			}
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	if c.withFiles != nil {
+		var (
+			children []*File
+			parents  []int
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Files {
+				children = append(children, e)
+				parents = append(parents, i)
+			}
+		}
+		clones, err := c.withFiles.clone(ctx, cfg, children, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i, e := range clones {
+			builders[parents[i]].AddFileIDs(e.ID)
+		}
+	}
+	if c.withBlocked != nil {
+		var (
+			children []*User
+			parents  []int
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Blocked {
+				children = append(children, e)
+				parents = append(parents, i)
+			}
+		}
+		clones, err := c.withBlocked.clone(ctx, cfg, children, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i, e := range clones {
+			builders[parents[i]].AddBlockedIDs(e.ID)
+		}
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given GroupInfo entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *GroupInfoClient) Clone(ctx context.Context, node *GroupInfo, opts ...func(*GroupInfoCloner)) *GroupInfoCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &GroupInfoCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// GroupInfoCloner is the builder for cloning GroupInfo entities and their selected edges.
+type GroupInfoCloner struct {
+	config
+	node       *GroupInfo
+	mutators   []func(*GroupInfoCreate, *GroupInfo)
+	withGroups *GroupCloner
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the GroupInfo entity it was copied from.
+func (c *GroupInfoCloner) Mutate(fns ...func(*GroupInfoCreate, *GroupInfo)) *GroupInfoCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithGroups tells the cloner to clone the Group entities of the "groups" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *GroupInfoCloner) WithGroups(opts ...func(*GroupCloner)) *GroupInfoCloner {
+	cloner := &GroupCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withGroups = cloner
+	return c
+}
+
+// Save clones the GroupInfo entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *GroupInfoCloner) Save(ctx context.Context) (*GroupInfo, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *GroupInfoCloner) SaveX(ctx context.Context) *GroupInfo {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the GroupInfo entity with its selected edges, and clones them using the given config.
+func (c *GroupInfoCloner) save(ctx context.Context, cfg config) (*GroupInfo, error) {
+	query := NewGroupInfoClient(cfg).Query().Where(groupinfo.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*GroupInfo{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *GroupInfoCloner) load(query *GroupInfoQuery) {
+	if c.withGroups != nil {
+		query.WithGroups(c.withGroups.load)
+	}
+}
+
+// clone creates a copy of the given GroupInfo entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *GroupInfoCloner) clone(ctx context.Context, cfg config, nodes []*GroupInfo, set func(int, *GroupInfoCreate)) ([]*GroupInfo, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewGroupInfoClient(cfg)
+	builders := make([]*GroupInfoCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetDesc(n.Desc)
+		b.SetMaxUsers(n.MaxUsers)
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.withGroups != nil {
+		var (
+			children []*Group
+			parents  []*GroupInfo
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Groups {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withGroups.clone(ctx, cfg, children, func(i int, b *GroupCreate) {
+			b.SetInfoID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Item entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *ItemClient) Clone(ctx context.Context, node *Item, opts ...func(*ItemCloner)) *ItemCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &ItemCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// ItemCloner is the builder for cloning Item entities and their selected edges.
+type ItemCloner struct {
+	config
+	node     *Item
+	mutators []func(*ItemCreate, *Item)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Item entity it was copied from.
+func (c *ItemCloner) Mutate(fns ...func(*ItemCreate, *Item)) *ItemCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Item entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *ItemCloner) Save(ctx context.Context) (*Item, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *ItemCloner) SaveX(ctx context.Context) *Item {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Item entity with its selected edges, and clones them using the given config.
+func (c *ItemCloner) save(ctx context.Context, cfg config) (*Item, error) {
+	query := NewItemClient(cfg).Query().Where(item.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Item{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *ItemCloner) load(query *ItemQuery) {
+}
+
+// clone creates a copy of the given Item entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *ItemCloner) clone(ctx context.Context, cfg config, nodes []*Item, set func(int, *ItemCreate)) ([]*Item, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewItemClient(cfg)
+	builders := make([]*ItemCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if !isZeroValue(n.Text) {
+			b.SetText(n.Text)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given License entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *LicenseClient) Clone(ctx context.Context, node *License, opts ...func(*LicenseCloner)) *LicenseCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &LicenseCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// LicenseCloner is the builder for cloning License entities and their selected edges.
+type LicenseCloner struct {
+	config
+	node     *License
+	mutators []func(*LicenseCreate, *License)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the License entity it was copied from.
+func (c *LicenseCloner) Mutate(fns ...func(*LicenseCreate, *License)) *LicenseCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the License entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *LicenseCloner) Save(ctx context.Context) (*License, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *LicenseCloner) SaveX(ctx context.Context) *License {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the License entity with its selected edges, and clones them using the given config.
+func (c *LicenseCloner) save(ctx context.Context, cfg config) (*License, error) {
+	query := NewLicenseClient(cfg).Query().Where(license.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*License{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *LicenseCloner) load(query *LicenseQuery) {
+}
+
+// clone creates a copy of the given License entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *LicenseCloner) clone(ctx context.Context, cfg config, nodes []*License, set func(int, *LicenseCreate)) ([]*License, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewLicenseClient(cfg)
+	builders := make([]*LicenseCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetCreateTime(n.CreateTime)
+		b.SetUpdateTime(n.UpdateTime)
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Node entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *NodeClient) Clone(ctx context.Context, node *Node, opts ...func(*NodeCloner)) *NodeCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &NodeCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// NodeCloner is the builder for cloning Node entities and their selected edges.
+type NodeCloner struct {
+	config
+	node     *Node
+	mutators []func(*NodeCreate, *Node)
+	withNext *NodeCloner
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Node entity it was copied from.
+func (c *NodeCloner) Mutate(fns ...func(*NodeCreate, *Node)) *NodeCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithNext tells the cloner to clone the Node entities of the "next" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *NodeCloner) WithNext(opts ...func(*NodeCloner)) *NodeCloner {
+	cloner := &NodeCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withNext = cloner
+	return c
+}
+
+// Save clones the Node entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *NodeCloner) Save(ctx context.Context) (*Node, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *NodeCloner) SaveX(ctx context.Context) *Node {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Node entity with its selected edges, and clones them using the given config.
+func (c *NodeCloner) save(ctx context.Context, cfg config) (*Node, error) {
+	query := NewNodeClient(cfg).Query().Where(node.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Node{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *NodeCloner) load(query *NodeQuery) {
+	query.withFKs = true
+	if c.withNext != nil {
+		query.WithNext(c.withNext.load)
+	}
+}
+
+// clone creates a copy of the given Node entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *NodeCloner) clone(ctx context.Context, cfg config, nodes []*Node, set func(int, *NodeCreate)) ([]*Node, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewNodeClient(cfg)
+	builders := make([]*NodeCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if !isZeroValue(n.Value) {
+			b.SetValue(n.Value)
+		}
+		if n.UpdatedAt != nil {
+			b.SetUpdatedAt(*n.UpdatedAt)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.withNext != nil {
+		var (
+			children []*Node
+			parents  []*Node
+		)
+		for i, n := range nodes {
+			if e := n.Edges.Next; e != nil {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withNext.clone(ctx, cfg, children, func(i int, b *NodeCreate) {
+			b.SetPrevID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given PC entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *PCClient) Clone(ctx context.Context, node *PC, opts ...func(*PCCloner)) *PCCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &PCCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// PCCloner is the builder for cloning PC entities and their selected edges.
+type PCCloner struct {
+	config
+	node     *PC
+	mutators []func(*PCCreate, *PC)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the PC entity it was copied from.
+func (c *PCCloner) Mutate(fns ...func(*PCCreate, *PC)) *PCCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the PC entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *PCCloner) Save(ctx context.Context) (*PC, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *PCCloner) SaveX(ctx context.Context) *PC {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the PC entity with its selected edges, and clones them using the given config.
+func (c *PCCloner) save(ctx context.Context, cfg config) (*PC, error) {
+	query := NewPCClient(cfg).Query().Where(pc.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*PC{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *PCCloner) load(query *PCQuery) {
+}
+
+// clone creates a copy of the given PC entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *PCCloner) clone(ctx context.Context, cfg config, nodes []*PC, set func(int, *PCCreate)) ([]*PC, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewPCClient(cfg)
+	builders := make([]*PCCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Pet entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *PetClient) Clone(ctx context.Context, node *Pet, opts ...func(*PetCloner)) *PetCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &PetCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// PetCloner is the builder for cloning Pet entities and their selected edges.
+type PetCloner struct {
+	config
+	node     *Pet
+	mutators []func(*PetCreate, *Pet)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Pet entity it was copied from.
+func (c *PetCloner) Mutate(fns ...func(*PetCreate, *Pet)) *PetCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Pet entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *PetCloner) Save(ctx context.Context) (*Pet, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *PetCloner) SaveX(ctx context.Context) *Pet {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Pet entity with its selected edges, and clones them using the given config.
+func (c *PetCloner) save(ctx context.Context, cfg config) (*Pet, error) {
+	query := NewPetClient(cfg).Query().Where(pet.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Pet{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *PetCloner) load(query *PetQuery) {
+	query.withFKs = true
+}
+
+// clone creates a copy of the given Pet entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *PetCloner) clone(ctx context.Context, cfg config, nodes []*Pet, set func(int, *PetCreate)) ([]*Pet, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewPetClient(cfg)
+	builders := make([]*PetCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetAge(n.Age)
+		b.SetName(n.Name)
+		if !isZeroValue(n.UUID) {
+			b.SetUUID(n.UUID)
+		}
+		if !isZeroValue(n.Nickname) {
+			b.SetNickname(n.Nickname)
+		}
+		b.SetTrained(n.Trained)
+		if !isZeroValue(n.OptionalTime) {
+			b.SetOptionalTime(n.OptionalTime)
+		}
+		if n.user_pets != nil {
+			b.SetOwnerID(*n.user_pets)
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Spec entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *SpecClient) Clone(ctx context.Context, node *Spec, opts ...func(*SpecCloner)) *SpecCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &SpecCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// SpecCloner is the builder for cloning Spec entities and their selected edges.
+type SpecCloner struct {
+	config
+	node     *Spec
+	mutators []func(*SpecCreate, *Spec)
+	withCard bool
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Spec entity it was copied from.
+func (c *SpecCloner) Mutate(fns ...func(*SpecCreate, *Spec)) *SpecCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithCard tells the cloner to link the clone to the same Card entities
+// of the "card" edge. The Card entities themselves are not cloned.
+func (c *SpecCloner) WithCard() *SpecCloner {
+	c.withCard = true
+	return c
+}
+
+// Save clones the Spec entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *SpecCloner) Save(ctx context.Context) (*Spec, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *SpecCloner) SaveX(ctx context.Context) *Spec {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Spec entity with its selected edges, and clones them using the given config.
+func (c *SpecCloner) save(ctx context.Context, cfg config) (*Spec, error) {
+	query := NewSpecClient(cfg).Query().Where(spec.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Spec{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *SpecCloner) load(query *SpecQuery) {
+	if c.withCard {
+		query.WithCard(func(q *CardQuery) {
+			q.Select(card.FieldID)
+		})
+	}
+}
+
+// clone creates a copy of the given Spec entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *SpecCloner) clone(ctx context.Context, cfg config, nodes []*Spec, set func(int, *SpecCreate)) ([]*Spec, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewSpecClient(cfg)
+	builders := make([]*SpecCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if c.withCard {
+			for _, e := range n.Edges.Card {
+				b.AddCardIDs(e.ID)
+			}
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given Task entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *TaskClient) Clone(ctx context.Context, node *Task, opts ...func(*TaskCloner)) *TaskCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &TaskCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// TaskCloner is the builder for cloning Task entities and their selected edges.
+type TaskCloner struct {
+	config
+	node     *Task
+	mutators []func(*TaskCreate, *Task)
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the Task entity it was copied from.
+func (c *TaskCloner) Mutate(fns ...func(*TaskCreate, *Task)) *TaskCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// Save clones the Task entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *TaskCloner) Save(ctx context.Context) (*Task, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *TaskCloner) SaveX(ctx context.Context) *Task {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the Task entity with its selected edges, and clones them using the given config.
+func (c *TaskCloner) save(ctx context.Context, cfg config) (*Task, error) {
+	query := NewTaskClient(cfg).Query().Where(enttask.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*Task{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *TaskCloner) load(query *TaskQuery) {
+}
+
+// clone creates a copy of the given Task entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *TaskCloner) clone(ctx context.Context, cfg config, nodes []*Task, set func(int, *TaskCreate)) ([]*Task, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewTaskClient(cfg)
+	builders := make([]*TaskCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		b.SetPriority(n.Priority)
+		if !isZeroValue(n.Priorities) {
+			b.SetPriorities(n.Priorities)
+		}
+		if n.CreatedAt != nil {
+			b.SetCreatedAt(*n.CreatedAt)
+		}
+		if !isZeroValue(n.Name) {
+			b.SetName(n.Name)
+		}
+		if !isZeroValue(n.Owner) {
+			b.SetOwner(n.Owner)
+		}
+		if !isZeroValue(n.Order) {
+			b.SetOrder(n.Order)
+		}
+		if !isZeroValue(n.OrderOption) {
+			b.SetOrderOption(n.OrderOption)
+		}
+		b.SetOp(n.Op)
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clones, nil
+}
+
+// Clone returns a builder for cloning the given User entity with a new ID, configured using the given
+// options. Edges are cloned with the entity only if they were selected using the With<Edge> options. If the
+// context holds a transaction (see NewTxContext), the clone is created as part of it.
+func (c *UserClient) Clone(ctx context.Context, node *User, opts ...func(*UserCloner)) *UserCloner {
+	cfg := c.config
+	if tx := TxFromContext(ctx); tx != nil {
+		cfg = tx.config
+	}
+	cloner := &UserCloner{config: cfg, node: node}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	return cloner
+}
+
+// UserCloner is the builder for cloning User entities and their selected edges.
+type UserCloner struct {
+	config
+	node          *User
+	mutators      []func(*UserCreate, *User)
+	withCard      *CardCloner
+	withPets      *PetCloner
+	withFiles     *FileCloner
+	withTeam      *PetCloner
+	withChildren  *UserCloner
+	withGroups    bool
+	withFriends   bool
+	withFollowers bool
+	withFollowing bool
+}
+
+// Mutate adds functions for overriding the fields and edges of the clones. Each function is
+// called with the create builder of the clone, and the User entity it was copied from.
+func (c *UserCloner) Mutate(fns ...func(*UserCreate, *User)) *UserCloner {
+	c.mutators = append(c.mutators, fns...)
+	return c
+}
+
+// WithCard tells the cloner to clone the Card entities of the "card" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *UserCloner) WithCard(opts ...func(*CardCloner)) *UserCloner {
+	cloner := &CardCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withCard = cloner
+	return c
+}
+
+// WithPets tells the cloner to clone the Pet entities of the "pets" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *UserCloner) WithPets(opts ...func(*PetCloner)) *UserCloner {
+	cloner := &PetCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withPets = cloner
+	return c
+}
+
+// WithFiles tells the cloner to clone the File entities of the "files" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *UserCloner) WithFiles(opts ...func(*FileCloner)) *UserCloner {
+	cloner := &FileCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withFiles = cloner
+	return c
+}
+
+// WithTeam tells the cloner to clone the Pet entities of the "team" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *UserCloner) WithTeam(opts ...func(*PetCloner)) *UserCloner {
+	cloner := &PetCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withTeam = cloner
+	return c
+}
+
+// WithChildren tells the cloner to clone the User entities of the "children" edge
+// and attach them to the clone. The optional arguments are used for configuring their cloner.
+func (c *UserCloner) WithChildren(opts ...func(*UserCloner)) *UserCloner {
+	cloner := &UserCloner{config: c.config}
+	for _, opt := range opts {
+		opt(cloner)
+	}
+	c.withChildren = cloner
+	return c
+}
+
+// WithGroups tells the cloner to link the clone to the same Group entities
+// of the "groups" edge. The Group entities themselves are not cloned.
+func (c *UserCloner) WithGroups() *UserCloner {
+	c.withGroups = true
+	return c
+}
+
+// WithFriends tells the cloner to link the clone to the same User entities
+// of the "friends" edge. The User entities themselves are not cloned.
+func (c *UserCloner) WithFriends() *UserCloner {
+	c.withFriends = true
+	return c
+}
+
+// WithFollowers tells the cloner to link the clone to the same User entities
+// of the "followers" edge. The User entities themselves are not cloned.
+func (c *UserCloner) WithFollowers() *UserCloner {
+	c.withFollowers = true
+	return c
+}
+
+// WithFollowing tells the cloner to link the clone to the same User entities
+// of the "following" edge. The User entities themselves are not cloned.
+func (c *UserCloner) WithFollowing() *UserCloner {
+	c.withFollowing = true
+	return c
+}
+
+// Save clones the User entity and its selected edges within a transaction, and returns the clone.
+// If the client is already in a transaction, the clone is created as part of it.
+func (c *UserCloner) Save(ctx context.Context) (*User, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.save(ctx, c.config)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	node, err := c.save(ctx, cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ent: committing transaction: %w", err)
+	}
+	node.config.driver = c.driver
+	return node, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (c *UserCloner) SaveX(ctx context.Context) *User {
+	node, err := c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// save loads the User entity with its selected edges, and clones them using the given config.
+func (c *UserCloner) save(ctx context.Context, cfg config) (*User, error) {
+	query := NewUserClient(cfg).Query().Where(user.ID(c.node.ID))
+	c.load(query)
+	node, err := query.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := c.clone(ctx, cfg, []*User{node}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// load configures the query to load the foreign-keys and the edges that are needed for cloning.
+func (c *UserCloner) load(query *UserQuery) {
+	query.withFKs = true
+	if c.withCard != nil {
+		query.WithCard(c.withCard.load)
+	}
+	if c.withPets != nil {
+		query.WithPets(c.withPets.load)
+	}
+	if c.withFiles != nil {
+		query.WithFiles(c.withFiles.load)
+	}
+	if c.withTeam != nil {
+		query.WithTeam(c.withTeam.load)
+	}
+	if c.withChildren != nil {
+		query.WithChildren(c.withChildren.load)
+	}
+	if c.withGroups {
+		query.WithGroups(func(q *GroupQuery) {
+			q.Select(group.FieldID)
+		})
+	}
+	if c.withFriends {
+		query.WithFriends(func(q *UserQuery) {
+			q.Select(user.FieldID)
+		})
+	}
+	if c.withFollowers {
+		query.WithFollowers(func(q *UserQuery) {
+			q.Select(user.FieldID)
+		})
+	}
+	if c.withFollowing {
+		query.WithFollowing(func(q *UserQuery) {
+			q.Select(user.FieldID)
+		})
+	}
+}
+
+// clone creates a copy of the given User entities and their selected edges using bulk creates,
+// and returns the clones in the same order. If set is not nil, it is called with the index and the
+// create builder of each clone, before the mutators are applied.
+func (c *UserCloner) clone(ctx context.Context, cfg config, nodes []*User, set func(int, *UserCreate)) ([]*User, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	client := NewUserClient(cfg)
+	builders := make([]*UserCreate, len(nodes))
+	for i, n := range nodes {
+		b := client.Create()
+		if !isZeroValue(n.OptionalInt) {
+			b.SetOptionalInt(n.OptionalInt)
+		}
+		b.SetAge(n.Age)
+		b.SetName(n.Name)
+		b.SetLast(n.Last)
+		if !isZeroValue(n.Nickname) {
+			b.SetNickname(n.Nickname)
+		}
+		b.SetAddress(n.Address)
+		if !isZeroValue(n.Phone) {
+			b.SetPhone(n.Phone)
+		}
+		if !isZeroValue(n.Password) {
+			b.SetPassword(n.Password)
+		}
+		b.SetRole(n.Role)
+		b.SetEmployment(n.Employment)
+		if !isZeroValue(n.SSOCert) {
+			b.SetSSOCert(n.SSOCert)
+		}
+		if !isZeroValue(n.FilesCount) {
+			b.SetFilesCount(n.FilesCount)
+		}
+		if n.user_parent != nil {
+			b.SetParentID(*n.user_parent)
+		}
+		if c.withGroups {
+			for _, e := range n.Edges.Groups {
+				b.AddGroupIDs(e.ID)
+			}
+		}
+		if c.withFriends {
+			for _, e := range n.Edges.Friends {
+				b.AddFriendIDs(e.ID)
+			}
+		}
+		if c.withFollowers {
+			for _, e := range n.Edges.Followers {
+				b.AddFollowerIDs(e.ID)
+			}
+		}
+		if c.withFollowing {
+			for _, e := range n.Edges.Following {
+				b.AddFollowingIDs(e.ID)
+			}
+		}
+		if set != nil {
+			set(i, b)
+		}
+		for _, m := range c.mutators {
+			m(b, n)
+		}
+		builders[i] = b
+	}
+	clones, err := client.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.withCard != nil {
+		var (
+			children []*Card
+			parents  []*User
+		)
+		for i, n := range nodes {
+			if e := n.Edges.Card; e != nil {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withCard.clone(ctx, cfg, children, func(i int, b *CardCreate) {
+			b.SetOwnerID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.withPets != nil {
+		var (
+			children []*Pet
+			parents  []*User
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Pets {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withPets.clone(ctx, cfg, children, func(i int, b *PetCreate) {
+			b.SetOwnerID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.withFiles != nil {
+		var (
+			children []*File
+			parents  []*User
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Files {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withFiles.clone(ctx, cfg, children, func(i int, b *FileCreate) {
+			b.SetOwnerID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.withTeam != nil {
+		var (
+			children []*Pet
+			parents  []*User
+		)
+		for i, n := range nodes {
+			if e := n.Edges.Team; e != nil {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withTeam.clone(ctx, cfg, children, func(i int, b *PetCreate) {
+			b.SetTeamID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.withChildren != nil {
+		var (
+			children []*User
+			parents  []*User
+		)
+		for i, n := range nodes {
+			for _, e := range n.Edges.Children {
+				children = append(children, e)
+				parents = append(parents, clones[i])
+			}
+		}
+		_, err := c.withChildren.clone(ctx, cfg, children, func(i int, b *UserCreate) {
+			b.SetParentID(parents[i].ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return clones, nil
+}
+
+// isZeroValue reports if the given value is the zero value of its type.
+func isZeroValue(v any) bool {
+	rv := reflect.ValueOf(v)
+	return !rv.IsValid() || rv.IsZero()
+}
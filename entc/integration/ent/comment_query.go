@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/comment"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	schemadir "entgo.io/ent/entc/integration/ent/schema/dir"
 	"entgo.io/ent/schema/field"
 )
 
@@ -283,6 +284,7 @@ func (_q *CommentQuery) GroupBy(field string, fields ...string) *CommentGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = comment.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -520,11 +522,11 @@ func (_g *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -532,6 +534,59 @@ func (_g *CommentGroupBy) sqlScan(ctx context.Context, root *CommentQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// CommentGroupRow is a typed row that is returned by the CommentGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CommentGroupRow struct {
+	ID              int           `json:"id,omitempty" sql:"id"`
+	UniqueInt       int           `json:"unique_int,omitempty" sql:"unique_int"`
+	UniqueFloat     float64       `json:"unique_float,omitempty" sql:"unique_float"`
+	NillableInt     *int          `json:"nillable_int,omitempty" sql:"nillable_int"`
+	Table           string        `json:"table,omitempty" sql:"table"`
+	Dir             schemadir.Dir `json:"dir,omitempty" sql:"dir"`
+	Client          string        `json:"client,omitempty" sql:"client"`
+	Count           int           `json:"count,omitempty" sql:"count"`
+	SumUniqueInt    int           `json:"sum_unique_int,omitempty" sql:"sum_unique_int"`
+	MinUniqueInt    int           `json:"min_unique_int,omitempty" sql:"min_unique_int"`
+	MaxUniqueInt    int           `json:"max_unique_int,omitempty" sql:"max_unique_int"`
+	MeanUniqueInt   float64       `json:"mean_unique_int,omitempty" sql:"mean_unique_int"`
+	SumUniqueFloat  float64       `json:"sum_unique_float,omitempty" sql:"sum_unique_float"`
+	MinUniqueFloat  float64       `json:"min_unique_float,omitempty" sql:"min_unique_float"`
+	MaxUniqueFloat  float64       `json:"max_unique_float,omitempty" sql:"max_unique_float"`
+	MeanUniqueFloat float64       `json:"mean_unique_float,omitempty" sql:"mean_unique_float"`
+	SumNillableInt  int           `json:"sum_nillable_int,omitempty" sql:"sum_nillable_int"`
+	MinNillableInt  int           `json:"min_nillable_int,omitempty" sql:"min_nillable_int"`
+	MaxNillableInt  int           `json:"max_nillable_int,omitempty" sql:"max_nillable_int"`
+	MeanNillableInt float64       `json:"mean_nillable_int,omitempty" sql:"mean_nillable_int"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CommentGroupBy) Rows(ctx context.Context) ([]*CommentGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CommentGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CommentGroupBy) RowsX(ctx context.Context) []*CommentGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CommentSelect is the builder for selecting fields of Comment entities.
 type CommentSelect struct {
 	*CommentQuery
@@ -567,6 +622,9 @@ func (_s *CommentSelect) sqlScan(ctx context.Context, root *CommentQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
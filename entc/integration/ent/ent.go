@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -33,7 +35,6 @@ import (
 	"entgo.io/ent/entc/integration/ent/pc"
 	"entgo.io/ent/entc/integration/ent/pet"
 	"entgo.io/ent/entc/integration/ent/spec"
-
 	enttask "entgo.io/ent/entc/integration/ent/task"
 	"entgo.io/ent/entc/integration/ent/user"
 )
@@ -154,13 +155,390 @@ type AggregateFunc func(*sql.Selector) string
 //	Scan(ctx, &v)
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		return sql.As(fn(s), end)
+		expr := fn(s)
+		// Renamed results are not part of the typed rows.
+		reportAggregate(s, "")
+		return sql.As(expr, end)
+	}
+}
+
+// Time units for the Bucket aggregation function.
+const (
+	Minute = sql.Minute
+	Hour   = sql.Hour
+	Day    = sql.Day
+	Week   = sql.Week
+	Month  = sql.Month
+	Year   = sql.Year
+)
+
+// Bucket groups the rows by the given time field truncated to the given unit in UTC,
+// and selects the start time of each bucket. For example:
+//
+//	client.User.Query().
+//		GroupBy(user.FieldRole).
+//		Aggregate(ent.Bucket(user.FieldCreatedAt, ent.Day), ent.Count()).
+//		Rows(ctx)
+func Bucket(field string, unit sql.TimeUnit) AggregateFunc {
+	return BucketIn(field, unit, time.UTC)
+}
+
+// BucketIn is like Bucket, but truncates the time field in the given location.
+// The location must be loaded by its IANA name (e.g. "Europe/Berlin").
+func BucketIn(field string, unit sql.TimeUnit, loc *time.Location) AggregateFunc {
+	return func(s *sql.Selector) string {
+		c, err := groupColumn(s, field)
+		if err != nil {
+			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+			return ""
+		}
+		if loc == nil || loc == time.Local {
+			s.AddError(fmt.Errorf("ent: missing named location for time bucket of field %q", field))
+			return ""
+		}
+		x := sql.Col(c).Trunc(unit, loc.String())
+		x.SetDialect(s.Dialect())
+		expr, _ := x.Query()
+		if err := x.Err(); err != nil {
+			s.AddError(fmt.Errorf("ent: %w", err))
+			return ""
+		}
+		s.GroupBy(expr)
+		return sql.As(expr, "bucket")
+	}
+}
+
+// aggregateKey is the context key of the aggregation result that is reported
+// by the aggregation functions, and is used by the typed group-by rows.
+type aggregateKey struct{}
+
+// aggregateResult describes the result of an aggregation function.
+type aggregateResult struct {
+	name string // Column name of the result (e.g. "sum_age"), or empty if it is not a row field.
+}
+
+// reportAggregate reports the column name of the result of an aggregation function,
+// if it was requested by the typed group-by rows. The name is named after the function
+// and the aggregated column. For example, "sum_age" for the Sum function of the "age"
+// field. An empty name indicates the result is not part of the typed rows.
+func reportAggregate(s *sql.Selector, name string) {
+	if r, ok := s.Context().Value(aggregateKey{}).(*aggregateResult); ok {
+		r.name = name
+	}
+}
+
+// aggregateAs names the result of the aggregation function after the name that
+// was reported by the function. It is used for scanning the aggregation results
+// into the typed group-by rows. Results of functions that do not report their
+// names (e.g. custom functions) are returned as is.
+func aggregateAs(fn AggregateFunc) AggregateFunc {
+	return func(s *sql.Selector) string {
+		var (
+			r   = &aggregateResult{}
+			ctx = s.Context()
+		)
+		s.WithContext(context.WithValue(ctx, aggregateKey{}, r))
+		expr := fn(s)
+		s.WithContext(ctx)
+		if r.name == "" || expr == "" {
+			return expr
+		}
+		return sql.As(expr, r.name)
+	}
+}
+
+// EdgeField returns a reference to a field of the neighbors of the given edge. It can be
+// used for grouping and aggregating rows by the fields of their neighbors. For example:
+//
+//	client.User.Query().
+//		GroupBy(user.FieldCountry, ent.EdgeField(user.EdgeCompany, company.FieldIndustry)).
+//		Aggregate(ent.Sum(ent.EdgeField(user.EdgeOrders, order.FieldAmount))).
+//		Scan(ctx, &v)
+//
+// Edge fields are selected as "<edge>_<field>", for example, "company_industry".
+func EdgeField(edge, field string) string {
+	return edge + "." + field
+}
+
+// groupByEdgeFields splits the given group-by fields into the fields of the node,
+// and aggregation functions that group the rows by the given edge fields.
+func groupByEdgeFields(fields []string) ([]string, []AggregateFunc) {
+	var (
+		own = make([]string, 0, len(fields))
+		fns []AggregateFunc
+	)
+	for _, f := range fields {
+		if !strings.Contains(f, ".") {
+			own = append(own, f)
+			continue
+		}
+		fns = append(fns, func(s *sql.Selector) string {
+			c, err := groupColumn(s, f)
+			if err != nil {
+				s.AddError(&ValidationError{Name: f, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			s.GroupBy(c)
+			return sql.As(c, strings.ReplaceAll(f, ".", "_"))
+		})
+	}
+	return own, fns
+}
+
+// groupColumn returns the column of the given field in the selector. Edge fields
+// are resolved by joining the neighbors of the edge to the selector. Fields of
+// non-unique edges are rejected, as their neighbors would multiply the rows of
+// the selector, and the results of the aggregation functions accordingly.
+func groupColumn(s *sql.Selector, field string) (string, error) {
+	edge, column, ok := strings.Cut(field, ".")
+	if !ok {
+		if err := checkColumn(s.TableName(), field); err != nil {
+			return "", err
+		}
+		return s.C(field), nil
+	}
+	step, err := edgeStep(s, edge)
+	if err != nil {
+		return "", err
 	}
+	if step.Edge.Rel == sqlgraph.O2M || step.Edge.Rel == sqlgraph.M2M {
+		return "", fmt.Errorf("cannot group by field %q of non-unique edge %q", column, edge)
+	}
+	if err := checkColumn(step.To.Table, column); err != nil {
+		return "", err
+	}
+	return sqlgraph.JoinNeighbors(s, step).C(column), nil
+}
+
+// aggregateEdgeField applies the aggregation function (e.g. "Sum") on the given edge field.
+// The neighbors are aggregated for each node in a sub-query, and the results are aggregated
+// again by the selector. Hence, the neighbors do not multiply the rows of the selector.
+func aggregateEdgeField(s *sql.Selector, fn, field string) (string, error) {
+	edge, column, _ := strings.Cut(field, ".")
+	step, err := edgeStep(s, edge)
+	if err != nil {
+		return "", err
+	}
+	if err := checkColumn(step.To.Table, column); err != nil {
+		return "", err
+	}
+	aggs := map[string]func(string) string{"Sum": sql.Sum, "Min": sql.Min, "Max": sql.Max, "Count": sql.Count}
+	neighbors := func(fn string) string {
+		return sqlgraph.AggregateNeighbors(s, step, strings.ToLower(fn)+"_"+column, func(t *sql.SelectTable) string {
+			return aggs[fn](t.C(column))
+		})
+	}
+	if fn == "Mean" {
+		// The mean of the neighbors is the sum of their values divided by their number.
+		return fmt.Sprintf("%s * 1.0 / NULLIF(%s, 0)", sql.Sum(neighbors("Sum")), sql.Sum(neighbors("Count"))), nil
+	}
+	return aggs[fn](neighbors(fn)), nil
+}
+
+// edgeStep returns the step from the table of the selector to the neighbors of the given edge.
+func edgeStep(s *sql.Selector, edge string) (*sqlgraph.Step, error) {
+	var step *sqlgraph.Step
+	switch s.TableName() {
+	case card.Table:
+		switch edge {
+		case card.EdgeOwner:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(card.Table, card.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, card.OwnerTable, card.OwnerColumn),
+			)
+		case card.EdgeSpec:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(card.Table, card.FieldID),
+				sqlgraph.To(spec.Table, spec.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, card.SpecTable, card.SpecPrimaryKey...),
+			)
+		}
+	case file.Table:
+		switch edge {
+		case file.EdgeOwner:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, file.OwnerTable, file.OwnerColumn),
+			)
+		case file.EdgeType:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(filetype.Table, filetype.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, file.TypeTable, file.TypeColumn),
+			)
+		case file.EdgeField:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(file.Table, file.FieldID),
+				sqlgraph.To(fieldtype.Table, fieldtype.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, file.FieldTable, file.FieldColumn),
+			)
+		}
+	case filetype.Table:
+		switch edge {
+		case filetype.EdgeFiles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(filetype.Table, filetype.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, filetype.FilesTable, filetype.FilesColumn),
+			)
+		}
+	case group.Table:
+		switch edge {
+		case group.EdgeFiles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, group.FilesTable, group.FilesColumn),
+			)
+		case group.EdgeBlocked:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, group.BlockedTable, group.BlockedColumn),
+			)
+		case group.EdgeUsers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, group.UsersTable, group.UsersPrimaryKey...),
+			)
+		case group.EdgeInfo:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(group.Table, group.FieldID),
+				sqlgraph.To(groupinfo.Table, groupinfo.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, group.InfoTable, group.InfoColumn),
+			)
+		}
+	case groupinfo.Table:
+		switch edge {
+		case groupinfo.EdgeGroups:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(groupinfo.Table, groupinfo.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, groupinfo.GroupsTable, groupinfo.GroupsColumn),
+			)
+		}
+	case node.Table:
+		switch edge {
+		case node.EdgePrev:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, node.PrevTable, node.PrevColumn),
+			)
+		case node.EdgeNext:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(node.Table, node.FieldID),
+				sqlgraph.To(node.Table, node.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, node.NextTable, node.NextColumn),
+			)
+		}
+	case pet.Table:
+		switch edge {
+		case pet.EdgeTeam:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, true, pet.TeamTable, pet.TeamColumn),
+			)
+		case pet.EdgeOwner:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(pet.Table, pet.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, true, pet.OwnerTable, pet.OwnerColumn),
+			)
+		}
+	case spec.Table:
+		switch edge {
+		case spec.EdgeCard:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(spec.Table, spec.FieldID),
+				sqlgraph.To(card.Table, card.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, spec.CardTable, spec.CardPrimaryKey...),
+			)
+		}
+	case user.Table:
+		switch edge {
+		case user.EdgeCard:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(card.Table, card.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.CardTable, user.CardColumn),
+			)
+		case user.EdgePets:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.PetsTable, user.PetsColumn),
+			)
+		case user.EdgeFiles:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(file.Table, file.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, false, user.FilesTable, user.FilesColumn),
+			)
+		case user.EdgeGroups:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(group.Table, group.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.GroupsTable, user.GroupsPrimaryKey...),
+			)
+		case user.EdgeFriends:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FriendsTable, user.FriendsPrimaryKey...),
+			)
+		case user.EdgeFollowers:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, true, user.FollowersTable, user.FollowersPrimaryKey...),
+			)
+		case user.EdgeFollowing:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2M, false, user.FollowingTable, user.FollowingPrimaryKey...),
+			)
+		case user.EdgeTeam:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(pet.Table, pet.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.TeamTable, user.TeamColumn),
+			)
+		case user.EdgeSpouse:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2O, false, user.SpouseTable, user.SpouseColumn),
+			)
+		case user.EdgeChildren:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.O2M, true, user.ChildrenTable, user.ChildrenColumn),
+			)
+		case user.EdgeParent:
+			step = sqlgraph.NewStep(
+				sqlgraph.From(user.Table, user.FieldID),
+				sqlgraph.To(user.Table, user.FieldID),
+				sqlgraph.Edge(sqlgraph.M2O, false, user.ParentTable, user.ParentColumn),
+			)
+		}
+	}
+	if step == nil {
+		return nil, fmt.Errorf("unknown edge %q for table %q", edge, s.TableName())
+	}
+	return step, nil
 }
 
 // Count applies the "count" aggregation function on each group.
 func Count() AggregateFunc {
 	return func(s *sql.Selector) string {
+		reportAggregate(s, "count")
 		return sql.Count("*")
 	}
 }
@@ -168,44 +546,88 @@ func Count() AggregateFunc {
 // Max applies the "max" aggregation function on the given field of each group.
 func Max(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Max", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Max(s.C(field))
+		reportAggregate(s, "max_"+field)
+		return sql.Max(c)
 	}
 }
 
 // Mean applies the "mean" aggregation function on the given field of each group.
 func Mean(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Mean", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Avg(s.C(field))
+		reportAggregate(s, "mean_"+field)
+		return sql.Avg(c)
 	}
 }
 
 // Min applies the "min" aggregation function on the given field of each group.
 func Min(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Min", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Min(s.C(field))
+		reportAggregate(s, "min_"+field)
+		return sql.Min(c)
 	}
 }
 
 // Sum applies the "sum" aggregation function on the given field of each group.
 func Sum(field string) AggregateFunc {
 	return func(s *sql.Selector) string {
-		if err := checkColumn(s.TableName(), field); err != nil {
+		// Aggregations of edge fields are not part of the typed rows.
+		if strings.Contains(field, ".") {
+			expr, err := aggregateEdgeField(s, "Sum", field)
+			if err != nil {
+				s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
+				return ""
+			}
+			return expr
+		}
+		c, err := groupColumn(s, field)
+		if err != nil {
 			s.AddError(&ValidationError{Name: field, err: fmt.Errorf("ent: %w", err)})
 			return ""
 		}
-		return sql.Sum(s.C(field))
+		reportAggregate(s, "sum_"+field)
+		return sql.Sum(c)
 	}
 }
 
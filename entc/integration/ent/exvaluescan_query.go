@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/big"
+	"net/url"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -283,6 +285,7 @@ func (_q *ExValueScanQuery) GroupBy(field string, fields ...string) *ExValueScan
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = exvaluescan.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -520,11 +523,11 @@ func (_g *ExValueScanGroupBy) sqlScan(ctx context.Context, root *ExValueScanQuer
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -532,6 +535,49 @@ func (_g *ExValueScanGroupBy) sqlScan(ctx context.Context, root *ExValueScanQuer
 	return sql.ScanSlice(rows, v)
 }
 
+// ExValueScanGroupRow is a typed row that is returned by the ExValueScanGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ExValueScanGroupRow struct {
+	ID             int      `json:"id,omitempty" sql:"id"`
+	Binary         *url.URL `json:"binary,omitempty" sql:"binary"`
+	BinaryBytes    *url.URL `json:"binary_bytes,omitempty" sql:"binary_bytes"`
+	BinaryOptional *url.URL `json:"binary_optional,omitempty" sql:"binary_optional"`
+	Text           *big.Int `json:"text,omitempty" sql:"text"`
+	TextOptional   *big.Int `json:"text_optional,omitempty" sql:"text_optional"`
+	Base64         string   `json:"base64,omitempty" sql:"base64"`
+	Custom         string   `json:"custom,omitempty" sql:"custom"`
+	CustomOptional string   `json:"custom_optional,omitempty" sql:"custom_optional"`
+	Count          int      `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ExValueScanGroupBy) Rows(ctx context.Context) ([]*ExValueScanGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ExValueScanGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ExValueScanGroupBy) RowsX(ctx context.Context) []*ExValueScanGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ExValueScanSelect is the builder for selecting fields of ExValueScan entities.
 type ExValueScanSelect struct {
 	*ExValueScanQuery
@@ -567,6 +613,9 @@ func (_s *ExValueScanSelect) sqlScan(ctx context.Context, root *ExValueScanQuery
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
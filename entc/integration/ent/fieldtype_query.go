@@ -10,6 +10,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net"
+	"net/http"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -17,7 +20,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	"entgo.io/ent/entc/integration/ent/role"
+	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // FieldTypeQuery is the builder for querying FieldType entities.
@@ -284,6 +290,7 @@ func (_q *FieldTypeQuery) GroupBy(field string, fields ...string) *FieldTypeGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = fieldtype.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -525,11 +532,11 @@ func (_g *FieldTypeGroupBy) sqlScan(ctx context.Context, root *FieldTypeQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -537,6 +544,204 @@ func (_g *FieldTypeGroupBy) sqlScan(ctx context.Context, root *FieldTypeQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// FieldTypeGroupRow is a typed row that is returned by the FieldTypeGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type FieldTypeGroupRow struct {
+	ID                        int                   `json:"id,omitempty" sql:"id"`
+	Int                       int                   `json:"int,omitempty" sql:"int"`
+	Int8                      int8                  `json:"int8,omitempty" sql:"int8"`
+	Int16                     int16                 `json:"int16,omitempty" sql:"int16"`
+	Int32                     int32                 `json:"int32,omitempty" sql:"int32"`
+	Int64                     int64                 `json:"int64,omitempty" sql:"int64"`
+	OptionalInt               int                   `json:"optional_int,omitempty" sql:"optional_int"`
+	OptionalInt8              int8                  `json:"optional_int8,omitempty" sql:"optional_int8"`
+	OptionalInt16             int16                 `json:"optional_int16,omitempty" sql:"optional_int16"`
+	OptionalInt32             int32                 `json:"optional_int32,omitempty" sql:"optional_int32"`
+	OptionalInt64             int64                 `json:"optional_int64,omitempty" sql:"optional_int64"`
+	NillableInt               *int                  `json:"nillable_int,omitempty" sql:"nillable_int"`
+	NillableInt8              *int8                 `json:"nillable_int8,omitempty" sql:"nillable_int8"`
+	NillableInt16             *int16                `json:"nillable_int16,omitempty" sql:"nillable_int16"`
+	NillableInt32             *int32                `json:"nillable_int32,omitempty" sql:"nillable_int32"`
+	NillableInt64             *int64                `json:"nillable_int64,omitempty" sql:"nillable_int64"`
+	ValidateOptionalInt32     int32                 `json:"validate_optional_int32,omitempty" sql:"validate_optional_int32"`
+	OptionalUint              uint                  `json:"optional_uint,omitempty" sql:"optional_uint"`
+	OptionalUint8             uint8                 `json:"optional_uint8,omitempty" sql:"optional_uint8"`
+	OptionalUint16            uint16                `json:"optional_uint16,omitempty" sql:"optional_uint16"`
+	OptionalUint32            uint32                `json:"optional_uint32,omitempty" sql:"optional_uint32"`
+	OptionalUint64            uint64                `json:"optional_uint64,omitempty" sql:"optional_uint64"`
+	State                     fieldtype.State       `json:"state,omitempty" sql:"state"`
+	OptionalFloat             float64               `json:"optional_float,omitempty" sql:"optional_float"`
+	OptionalFloat32           float32               `json:"optional_float32,omitempty" sql:"optional_float32"`
+	Text                      string                `json:"text,omitempty" sql:"text"`
+	Datetime                  time.Time             `json:"datetime,omitempty" sql:"datetime"`
+	Decimal                   float64               `json:"decimal,omitempty" sql:"decimal"`
+	LinkOther                 *schema.Link          `json:"link_other,omitempty" sql:"link_other"`
+	LinkOtherFunc             *schema.Link          `json:"link_other_func,omitempty" sql:"link_other_func"`
+	MAC                       schema.MAC            `json:"mac,omitempty" sql:"mac"`
+	StringArray               schema.Strings        `json:"string_array,omitempty" sql:"string_array"`
+	Password                  string                `json:"password,omitempty" sql:"password"`
+	StringScanner             *schema.StringScanner `json:"string_scanner,omitempty" sql:"string_scanner"`
+	Duration                  time.Duration         `json:"duration,omitempty" sql:"duration"`
+	Dir                       http.Dir              `json:"dir,omitempty" sql:"dir"`
+	Ndir                      *http.Dir             `json:"ndir,omitempty" sql:"ndir"`
+	Str                       sql.NullString        `json:"str,omitempty" sql:"str"`
+	NullStr                   *sql.NullString       `json:"null_str,omitempty" sql:"null_str"`
+	Link                      schema.Link           `json:"link,omitempty" sql:"link"`
+	NullLink                  *schema.Link          `json:"null_link,omitempty" sql:"null_link"`
+	Active                    schema.Status         `json:"active,omitempty" sql:"active"`
+	NullActive                *schema.Status        `json:"null_active,omitempty" sql:"null_active"`
+	Deleted                   *sql.NullBool         `json:"deleted,omitempty" sql:"deleted"`
+	DeletedAt                 *sql.NullTime         `json:"deleted_at,omitempty" sql:"deleted_at"`
+	RawData                   []byte                `json:"raw_data,omitempty" sql:"raw_data"`
+	Sensitive                 []byte                `json:"sensitive,omitempty" sql:"sensitive"`
+	IP                        net.IP                `json:"ip,omitempty" sql:"ip"`
+	NullInt64                 *sql.NullInt64        `json:"null_int64,omitempty" sql:"null_int64"`
+	SchemaInt                 schema.Int            `json:"schema_int,omitempty" sql:"schema_int"`
+	SchemaInt8                schema.Int8           `json:"schema_int8,omitempty" sql:"schema_int8"`
+	SchemaInt64               schema.Int64          `json:"schema_int64,omitempty" sql:"schema_int64"`
+	SchemaFloat               schema.Float64        `json:"schema_float,omitempty" sql:"schema_float"`
+	SchemaFloat32             schema.Float32        `json:"schema_float32,omitempty" sql:"schema_float32"`
+	NullFloat                 *sql.NullFloat64      `json:"null_float,omitempty" sql:"null_float"`
+	Role                      role.Role             `json:"role,omitempty" sql:"role"`
+	Priority                  role.Priority         `json:"priority,omitempty" sql:"priority"`
+	OptionalUUID              uuid.UUID             `json:"optional_uuid,omitempty" sql:"optional_uuid"`
+	NillableUUID              *uuid.UUID            `json:"nillable_uuid,omitempty" sql:"nillable_uuid"`
+	Strings                   []string              `json:"strings,omitempty" sql:"strings"`
+	Pair                      schema.Pair           `json:"pair,omitempty" sql:"pair"`
+	NilPair                   *schema.Pair          `json:"nil_pair,omitempty" sql:"nil_pair"`
+	Vstring                   schema.VString        `json:"vstring,omitempty" sql:"vstring"`
+	Triple                    schema.Triple         `json:"triple,omitempty" sql:"triple"`
+	BigInt                    schema.BigInt         `json:"big_int,omitempty" sql:"big_int"`
+	PasswordOther             schema.Password       `json:"password_other,omitempty" sql:"password_other"`
+	Bucket                    time.Time             `json:"bucket,omitempty" sql:"bucket"`
+	Count                     int                   `json:"count,omitempty" sql:"count"`
+	SumInt                    int                   `json:"sum_int,omitempty" sql:"sum_int"`
+	MinInt                    int                   `json:"min_int,omitempty" sql:"min_int"`
+	MaxInt                    int                   `json:"max_int,omitempty" sql:"max_int"`
+	MeanInt                   float64               `json:"mean_int,omitempty" sql:"mean_int"`
+	SumInt8                   int8                  `json:"sum_int8,omitempty" sql:"sum_int8"`
+	MinInt8                   int8                  `json:"min_int8,omitempty" sql:"min_int8"`
+	MaxInt8                   int8                  `json:"max_int8,omitempty" sql:"max_int8"`
+	MeanInt8                  float64               `json:"mean_int8,omitempty" sql:"mean_int8"`
+	SumInt16                  int16                 `json:"sum_int16,omitempty" sql:"sum_int16"`
+	MinInt16                  int16                 `json:"min_int16,omitempty" sql:"min_int16"`
+	MaxInt16                  int16                 `json:"max_int16,omitempty" sql:"max_int16"`
+	MeanInt16                 float64               `json:"mean_int16,omitempty" sql:"mean_int16"`
+	SumInt32                  int32                 `json:"sum_int32,omitempty" sql:"sum_int32"`
+	MinInt32                  int32                 `json:"min_int32,omitempty" sql:"min_int32"`
+	MaxInt32                  int32                 `json:"max_int32,omitempty" sql:"max_int32"`
+	MeanInt32                 float64               `json:"mean_int32,omitempty" sql:"mean_int32"`
+	SumInt64                  int64                 `json:"sum_int64,omitempty" sql:"sum_int64"`
+	MinInt64                  int64                 `json:"min_int64,omitempty" sql:"min_int64"`
+	MaxInt64                  int64                 `json:"max_int64,omitempty" sql:"max_int64"`
+	MeanInt64                 float64               `json:"mean_int64,omitempty" sql:"mean_int64"`
+	SumOptionalInt            int                   `json:"sum_optional_int,omitempty" sql:"sum_optional_int"`
+	MinOptionalInt            int                   `json:"min_optional_int,omitempty" sql:"min_optional_int"`
+	MaxOptionalInt            int                   `json:"max_optional_int,omitempty" sql:"max_optional_int"`
+	MeanOptionalInt           float64               `json:"mean_optional_int,omitempty" sql:"mean_optional_int"`
+	SumOptionalInt8           int8                  `json:"sum_optional_int8,omitempty" sql:"sum_optional_int8"`
+	MinOptionalInt8           int8                  `json:"min_optional_int8,omitempty" sql:"min_optional_int8"`
+	MaxOptionalInt8           int8                  `json:"max_optional_int8,omitempty" sql:"max_optional_int8"`
+	MeanOptionalInt8          float64               `json:"mean_optional_int8,omitempty" sql:"mean_optional_int8"`
+	SumOptionalInt16          int16                 `json:"sum_optional_int16,omitempty" sql:"sum_optional_int16"`
+	MinOptionalInt16          int16                 `json:"min_optional_int16,omitempty" sql:"min_optional_int16"`
+	MaxOptionalInt16          int16                 `json:"max_optional_int16,omitempty" sql:"max_optional_int16"`
+	MeanOptionalInt16         float64               `json:"mean_optional_int16,omitempty" sql:"mean_optional_int16"`
+	SumOptionalInt32          int32                 `json:"sum_optional_int32,omitempty" sql:"sum_optional_int32"`
+	MinOptionalInt32          int32                 `json:"min_optional_int32,omitempty" sql:"min_optional_int32"`
+	MaxOptionalInt32          int32                 `json:"max_optional_int32,omitempty" sql:"max_optional_int32"`
+	MeanOptionalInt32         float64               `json:"mean_optional_int32,omitempty" sql:"mean_optional_int32"`
+	SumOptionalInt64          int64                 `json:"sum_optional_int64,omitempty" sql:"sum_optional_int64"`
+	MinOptionalInt64          int64                 `json:"min_optional_int64,omitempty" sql:"min_optional_int64"`
+	MaxOptionalInt64          int64                 `json:"max_optional_int64,omitempty" sql:"max_optional_int64"`
+	MeanOptionalInt64         float64               `json:"mean_optional_int64,omitempty" sql:"mean_optional_int64"`
+	SumNillableInt            int                   `json:"sum_nillable_int,omitempty" sql:"sum_nillable_int"`
+	MinNillableInt            int                   `json:"min_nillable_int,omitempty" sql:"min_nillable_int"`
+	MaxNillableInt            int                   `json:"max_nillable_int,omitempty" sql:"max_nillable_int"`
+	MeanNillableInt           float64               `json:"mean_nillable_int,omitempty" sql:"mean_nillable_int"`
+	SumNillableInt8           int8                  `json:"sum_nillable_int8,omitempty" sql:"sum_nillable_int8"`
+	MinNillableInt8           int8                  `json:"min_nillable_int8,omitempty" sql:"min_nillable_int8"`
+	MaxNillableInt8           int8                  `json:"max_nillable_int8,omitempty" sql:"max_nillable_int8"`
+	MeanNillableInt8          float64               `json:"mean_nillable_int8,omitempty" sql:"mean_nillable_int8"`
+	SumNillableInt16          int16                 `json:"sum_nillable_int16,omitempty" sql:"sum_nillable_int16"`
+	MinNillableInt16          int16                 `json:"min_nillable_int16,omitempty" sql:"min_nillable_int16"`
+	MaxNillableInt16          int16                 `json:"max_nillable_int16,omitempty" sql:"max_nillable_int16"`
+	MeanNillableInt16         float64               `json:"mean_nillable_int16,omitempty" sql:"mean_nillable_int16"`
+	SumNillableInt32          int32                 `json:"sum_nillable_int32,omitempty" sql:"sum_nillable_int32"`
+	MinNillableInt32          int32                 `json:"min_nillable_int32,omitempty" sql:"min_nillable_int32"`
+	MaxNillableInt32          int32                 `json:"max_nillable_int32,omitempty" sql:"max_nillable_int32"`
+	MeanNillableInt32         float64               `json:"mean_nillable_int32,omitempty" sql:"mean_nillable_int32"`
+	SumNillableInt64          int64                 `json:"sum_nillable_int64,omitempty" sql:"sum_nillable_int64"`
+	MinNillableInt64          int64                 `json:"min_nillable_int64,omitempty" sql:"min_nillable_int64"`
+	MaxNillableInt64          int64                 `json:"max_nillable_int64,omitempty" sql:"max_nillable_int64"`
+	MeanNillableInt64         float64               `json:"mean_nillable_int64,omitempty" sql:"mean_nillable_int64"`
+	SumValidateOptionalInt32  int32                 `json:"sum_validate_optional_int32,omitempty" sql:"sum_validate_optional_int32"`
+	MinValidateOptionalInt32  int32                 `json:"min_validate_optional_int32,omitempty" sql:"min_validate_optional_int32"`
+	MaxValidateOptionalInt32  int32                 `json:"max_validate_optional_int32,omitempty" sql:"max_validate_optional_int32"`
+	MeanValidateOptionalInt32 float64               `json:"mean_validate_optional_int32,omitempty" sql:"mean_validate_optional_int32"`
+	SumOptionalUint           uint                  `json:"sum_optional_uint,omitempty" sql:"sum_optional_uint"`
+	MinOptionalUint           uint                  `json:"min_optional_uint,omitempty" sql:"min_optional_uint"`
+	MaxOptionalUint           uint                  `json:"max_optional_uint,omitempty" sql:"max_optional_uint"`
+	MeanOptionalUint          float64               `json:"mean_optional_uint,omitempty" sql:"mean_optional_uint"`
+	SumOptionalUint8          uint8                 `json:"sum_optional_uint8,omitempty" sql:"sum_optional_uint8"`
+	MinOptionalUint8          uint8                 `json:"min_optional_uint8,omitempty" sql:"min_optional_uint8"`
+	MaxOptionalUint8          uint8                 `json:"max_optional_uint8,omitempty" sql:"max_optional_uint8"`
+	MeanOptionalUint8         float64               `json:"mean_optional_uint8,omitempty" sql:"mean_optional_uint8"`
+	SumOptionalUint16         uint16                `json:"sum_optional_uint16,omitempty" sql:"sum_optional_uint16"`
+	MinOptionalUint16         uint16                `json:"min_optional_uint16,omitempty" sql:"min_optional_uint16"`
+	MaxOptionalUint16         uint16                `json:"max_optional_uint16,omitempty" sql:"max_optional_uint16"`
+	MeanOptionalUint16        float64               `json:"mean_optional_uint16,omitempty" sql:"mean_optional_uint16"`
+	SumOptionalUint32         uint32                `json:"sum_optional_uint32,omitempty" sql:"sum_optional_uint32"`
+	MinOptionalUint32         uint32                `json:"min_optional_uint32,omitempty" sql:"min_optional_uint32"`
+	MaxOptionalUint32         uint32                `json:"max_optional_uint32,omitempty" sql:"max_optional_uint32"`
+	MeanOptionalUint32        float64               `json:"mean_optional_uint32,omitempty" sql:"mean_optional_uint32"`
+	SumOptionalUint64         uint64                `json:"sum_optional_uint64,omitempty" sql:"sum_optional_uint64"`
+	MinOptionalUint64         uint64                `json:"min_optional_uint64,omitempty" sql:"min_optional_uint64"`
+	MaxOptionalUint64         uint64                `json:"max_optional_uint64,omitempty" sql:"max_optional_uint64"`
+	MeanOptionalUint64        float64               `json:"mean_optional_uint64,omitempty" sql:"mean_optional_uint64"`
+	SumOptionalFloat          float64               `json:"sum_optional_float,omitempty" sql:"sum_optional_float"`
+	MinOptionalFloat          float64               `json:"min_optional_float,omitempty" sql:"min_optional_float"`
+	MaxOptionalFloat          float64               `json:"max_optional_float,omitempty" sql:"max_optional_float"`
+	MeanOptionalFloat         float64               `json:"mean_optional_float,omitempty" sql:"mean_optional_float"`
+	SumOptionalFloat32        float32               `json:"sum_optional_float32,omitempty" sql:"sum_optional_float32"`
+	MinOptionalFloat32        float32               `json:"min_optional_float32,omitempty" sql:"min_optional_float32"`
+	MaxOptionalFloat32        float32               `json:"max_optional_float32,omitempty" sql:"max_optional_float32"`
+	MeanOptionalFloat32       float64               `json:"mean_optional_float32,omitempty" sql:"mean_optional_float32"`
+	SumDecimal                float64               `json:"sum_decimal,omitempty" sql:"sum_decimal"`
+	MinDecimal                float64               `json:"min_decimal,omitempty" sql:"min_decimal"`
+	MaxDecimal                float64               `json:"max_decimal,omitempty" sql:"max_decimal"`
+	MeanDecimal               float64               `json:"mean_decimal,omitempty" sql:"mean_decimal"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FieldTypeGroupBy) Rows(ctx context.Context) ([]*FieldTypeGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FieldTypeGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FieldTypeGroupBy) RowsX(ctx context.Context) []*FieldTypeGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FieldTypeSelect is the builder for selecting fields of FieldType entities.
 type FieldTypeSelect struct {
 	*FieldTypeQuery
@@ -572,6 +777,9 @@ func (_s *FieldTypeSelect) sqlScan(ctx context.Context, root *FieldTypeQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -394,6 +395,7 @@ func (_q *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = file.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -780,11 +782,11 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -792,6 +794,63 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// FileGroupRow is a typed row that is returned by the FileGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type FileGroupRow struct {
+	ID          int       `json:"id,omitempty" sql:"id"`
+	SetID       int       `json:"set_id,omitempty" sql:"set_id"`
+	Size        int       `json:"size,omitempty" sql:"fsize"`
+	Name        string    `json:"name,omitempty" sql:"name"`
+	User        *string   `json:"user,omitempty" sql:"user"`
+	Group       string    `json:"group,omitempty" sql:"group"`
+	Op          bool      `json:"op,omitempty" sql:"op"`
+	FieldID     int       `json:"field_id,omitempty" sql:"field_id"`
+	CreateTime  time.Time `json:"create_time,omitempty" sql:"create_time"`
+	Bucket      time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count       int       `json:"count,omitempty" sql:"count"`
+	SumSetID    int       `json:"sum_set_id,omitempty" sql:"sum_set_id"`
+	MinSetID    int       `json:"min_set_id,omitempty" sql:"min_set_id"`
+	MaxSetID    int       `json:"max_set_id,omitempty" sql:"max_set_id"`
+	MeanSetID   float64   `json:"mean_set_id,omitempty" sql:"mean_set_id"`
+	SumSize     int       `json:"sum_size,omitempty" sql:"sum_fsize"`
+	MinSize     int       `json:"min_size,omitempty" sql:"min_fsize"`
+	MaxSize     int       `json:"max_size,omitempty" sql:"max_fsize"`
+	MeanSize    float64   `json:"mean_size,omitempty" sql:"mean_fsize"`
+	SumFieldID  int       `json:"sum_field_id,omitempty" sql:"sum_field_id"`
+	MinFieldID  int       `json:"min_field_id,omitempty" sql:"min_field_id"`
+	MaxFieldID  int       `json:"max_field_id,omitempty" sql:"max_field_id"`
+	MeanFieldID float64   `json:"mean_field_id,omitempty" sql:"mean_field_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FileGroupBy) Rows(ctx context.Context) ([]*FileGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FileGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FileGroupBy) RowsX(ctx context.Context) []*FileGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FileSelect is the builder for selecting fields of File entities.
 type FileSelect struct {
 	*FileQuery
@@ -827,6 +886,9 @@ func (_s *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
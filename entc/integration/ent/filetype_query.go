@@ -321,6 +321,7 @@ func (_q *FileTypeQuery) GroupBy(field string, fields ...string) *FileTypeGroupB
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = filetype.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -632,11 +633,11 @@ func (_g *FileTypeGroupBy) sqlScan(ctx context.Context, root *FileTypeQuery, v a
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -644,6 +645,44 @@ func (_g *FileTypeGroupBy) sqlScan(ctx context.Context, root *FileTypeQuery, v a
 	return sql.ScanSlice(rows, v)
 }
 
+// FileTypeGroupRow is a typed row that is returned by the FileTypeGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type FileTypeGroupRow struct {
+	ID    int            `json:"id,omitempty" sql:"id"`
+	Name  string         `json:"name,omitempty" sql:"name"`
+	Type  filetype.Type  `json:"type,omitempty" sql:"type"`
+	State filetype.State `json:"state,omitempty" sql:"state"`
+	Count int            `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FileTypeGroupBy) Rows(ctx context.Context) ([]*FileTypeGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FileTypeGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FileTypeGroupBy) RowsX(ctx context.Context) []*FileTypeGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FileTypeSelect is the builder for selecting fields of FileType entities.
 type FileTypeSelect struct {
 	*FileTypeQuery
@@ -679,6 +718,9 @@ func (_s *FileTypeSelect) sqlScan(ctx context.Context, root *FileTypeQuery, v an
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
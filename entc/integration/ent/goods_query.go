@@ -271,6 +271,7 @@ func (_q *GoodsQuery) GroupBy(field string, fields ...string) *GoodsGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = goods.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -498,11 +499,11 @@ func (_g *GoodsGroupBy) sqlScan(ctx context.Context, root *GoodsQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -510,6 +511,41 @@ func (_g *GoodsGroupBy) sqlScan(ctx context.Context, root *GoodsQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// GoodsGroupRow is a typed row that is returned by the GoodsGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type GoodsGroupRow struct {
+	ID    int `json:"id,omitempty" sql:"id"`
+	Count int `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *GoodsGroupBy) Rows(ctx context.Context) ([]*GoodsGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*GoodsGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *GoodsGroupBy) RowsX(ctx context.Context) []*GoodsGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // GoodsSelect is the builder for selecting fields of Goods entities.
 type GoodsSelect struct {
 	*GoodsQuery
@@ -545,6 +581,9 @@ func (_s *GoodsSelect) sqlScan(ctx context.Context, root *GoodsQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -431,6 +432,7 @@ func (_q *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = group.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -928,11 +930,11 @@ func (_g *GroupGroupBy) sqlScan(ctx context.Context, root *GroupQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -940,6 +942,52 @@ func (_g *GroupGroupBy) sqlScan(ctx context.Context, root *GroupQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// GroupGroupRow is a typed row that is returned by the GroupGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type GroupGroupRow struct {
+	ID           int       `json:"id,omitempty" sql:"id"`
+	Active       bool      `json:"active,omitempty" sql:"active"`
+	Expire       time.Time `json:"expire,omitempty" sql:"expire"`
+	Type         *string   `json:"type,omitempty" sql:"type"`
+	MaxUsers     int       `json:"max_users,omitempty" sql:"max_users"`
+	Name         string    `json:"name,omitempty" sql:"name"`
+	Bucket       time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count        int       `json:"count,omitempty" sql:"count"`
+	SumMaxUsers  int       `json:"sum_max_users,omitempty" sql:"sum_max_users"`
+	MinMaxUsers  int       `json:"min_max_users,omitempty" sql:"min_max_users"`
+	MaxMaxUsers  int       `json:"max_max_users,omitempty" sql:"max_max_users"`
+	MeanMaxUsers float64   `json:"mean_max_users,omitempty" sql:"mean_max_users"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *GroupGroupBy) Rows(ctx context.Context) ([]*GroupGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*GroupGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *GroupGroupBy) RowsX(ctx context.Context) []*GroupGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // GroupSelect is the builder for selecting fields of Group entities.
 type GroupSelect struct {
 	*GroupQuery
@@ -975,6 +1023,9 @@ func (_s *GroupSelect) sqlScan(ctx context.Context, root *GroupQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -321,6 +321,7 @@ func (_q *GroupInfoQuery) GroupBy(field string, fields ...string) *GroupInfoGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = groupinfo.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -632,11 +633,11 @@ func (_g *GroupInfoGroupBy) sqlScan(ctx context.Context, root *GroupInfoQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -644,6 +645,47 @@ func (_g *GroupInfoGroupBy) sqlScan(ctx context.Context, root *GroupInfoQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// GroupInfoGroupRow is a typed row that is returned by the GroupInfoGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type GroupInfoGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	Desc         string  `json:"desc,omitempty" sql:"desc"`
+	MaxUsers     int     `json:"max_users,omitempty" sql:"max_users"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumMaxUsers  int     `json:"sum_max_users,omitempty" sql:"sum_max_users"`
+	MinMaxUsers  int     `json:"min_max_users,omitempty" sql:"min_max_users"`
+	MaxMaxUsers  int     `json:"max_max_users,omitempty" sql:"max_max_users"`
+	MeanMaxUsers float64 `json:"mean_max_users,omitempty" sql:"mean_max_users"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *GroupInfoGroupBy) Rows(ctx context.Context) ([]*GroupInfoGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*GroupInfoGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *GroupInfoGroupBy) RowsX(ctx context.Context) []*GroupInfoGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // GroupInfoSelect is the builder for selecting fields of GroupInfo entities.
 type GroupInfoSelect struct {
 	*GroupInfoQuery
@@ -679,6 +721,9 @@ func (_s *GroupInfoSelect) sqlScan(ctx context.Context, root *GroupInfoQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
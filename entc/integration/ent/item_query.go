@@ -283,6 +283,7 @@ func (_q *ItemQuery) GroupBy(field string, fields ...string) *ItemGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = item.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -520,11 +521,11 @@ func (_g *ItemGroupBy) sqlScan(ctx context.Context, root *ItemQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -532,6 +533,42 @@ func (_g *ItemGroupBy) sqlScan(ctx context.Context, root *ItemQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// ItemGroupRow is a typed row that is returned by the ItemGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ItemGroupRow struct {
+	ID    string `json:"id,omitempty" sql:"id"`
+	Text  string `json:"text,omitempty" sql:"text"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ItemGroupBy) Rows(ctx context.Context) ([]*ItemGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ItemGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ItemGroupBy) RowsX(ctx context.Context) []*ItemGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ItemSelect is the builder for selecting fields of Item entities.
 type ItemSelect struct {
 	*ItemQuery
@@ -567,6 +604,9 @@ func (_s *ItemSelect) sqlScan(ctx context.Context, root *ItemQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -283,6 +284,7 @@ func (_q *LicenseQuery) GroupBy(field string, fields ...string) *LicenseGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = license.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -520,11 +522,11 @@ func (_g *LicenseGroupBy) sqlScan(ctx context.Context, root *LicenseQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -532,6 +534,45 @@ func (_g *LicenseGroupBy) sqlScan(ctx context.Context, root *LicenseQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// LicenseGroupRow is a typed row that is returned by the LicenseGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type LicenseGroupRow struct {
+	ID         int       `json:"id,omitempty" sql:"id"`
+	CreateTime time.Time `json:"create_time,omitempty" sql:"create_time"`
+	UpdateTime time.Time `json:"update_time,omitempty" sql:"update_time"`
+	Bucket     time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count      int       `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *LicenseGroupBy) Rows(ctx context.Context) ([]*LicenseGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*LicenseGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *LicenseGroupBy) RowsX(ctx context.Context) []*LicenseGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // LicenseSelect is the builder for selecting fields of License entities.
 type LicenseSelect struct {
 	*LicenseQuery
@@ -567,6 +608,9 @@ func (_s *LicenseSelect) sqlScan(ctx context.Context, root *LicenseQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -271,6 +271,7 @@ func (_q *PCQuery) GroupBy(field string, fields ...string) *PCGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = pc.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -498,11 +499,11 @@ func (_g *PCGroupBy) sqlScan(ctx context.Context, root *PCQuery, v any) error {
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -510,6 +511,41 @@ func (_g *PCGroupBy) sqlScan(ctx context.Context, root *PCQuery, v any) error {
 	return sql.ScanSlice(rows, v)
 }
 
+// PCGroupRow is a typed row that is returned by the PCGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type PCGroupRow struct {
+	ID    int `json:"id,omitempty" sql:"id"`
+	Count int `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *PCGroupBy) Rows(ctx context.Context) ([]*PCGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*PCGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *PCGroupBy) RowsX(ctx context.Context) []*PCGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // PCSelect is the builder for selecting fields of PC entities.
 type PCSelect struct {
 	*PCQuery
@@ -545,6 +581,9 @@ func (_s *PCSelect) sqlScan(ctx context.Context, root *PCQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -19,6 +20,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // PetQuery is the builder for querying Pet entities.
@@ -355,6 +357,7 @@ func (_q *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = pet.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -686,11 +689,11 @@ func (_g *PetGroupBy) sqlScan(ctx context.Context, root *PetQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -698,6 +701,53 @@ func (_g *PetGroupBy) sqlScan(ctx context.Context, root *PetQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// PetGroupRow is a typed row that is returned by the PetGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type PetGroupRow struct {
+	ID           int       `json:"id,omitempty" sql:"id"`
+	Age          float64   `json:"age,omitempty" sql:"age"`
+	Name         string    `json:"name,omitempty" sql:"name"`
+	UUID         uuid.UUID `json:"uuid,omitempty" sql:"uuid"`
+	Nickname     string    `json:"nickname,omitempty" sql:"nickname"`
+	Trained      bool      `json:"trained,omitempty" sql:"trained"`
+	OptionalTime time.Time `json:"optional_time,omitempty" sql:"optional_time"`
+	Bucket       time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count        int       `json:"count,omitempty" sql:"count"`
+	SumAge       float64   `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge       float64   `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge       float64   `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge      float64   `json:"mean_age,omitempty" sql:"mean_age"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *PetGroupBy) Rows(ctx context.Context) ([]*PetGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*PetGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *PetGroupBy) RowsX(ctx context.Context) []*PetGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // PetSelect is the builder for selecting fields of Pet entities.
 type PetSelect struct {
 	*PetQuery
@@ -733,6 +783,9 @@ func (_s *PetSelect) sqlScan(ctx context.Context, root *PetQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
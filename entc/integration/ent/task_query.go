@@ -10,12 +10,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent/predicate"
+	"entgo.io/ent/entc/integration/ent/schema/task"
 	enttask "entgo.io/ent/entc/integration/ent/task"
 	"entgo.io/ent/schema/field"
 )
@@ -283,6 +285,7 @@ func (_q *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = enttask.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -520,11 +523,11 @@ func (_g *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -532,6 +535,59 @@ func (_g *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// TaskGroupRow is a typed row that is returned by the TaskGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type TaskGroupRow struct {
+	ID              int                      `json:"id,omitempty" sql:"id"`
+	Priority        task.Priority            `json:"priority,omitempty" sql:"priority"`
+	Priorities      map[string]task.Priority `json:"priorities,omitempty" sql:"priorities"`
+	CreatedAt       *time.Time               `json:"created_at,omitempty" sql:"created_at"`
+	Name            string                   `json:"name,omitempty" sql:"name"`
+	Owner           string                   `json:"owner,omitempty" sql:"owner"`
+	Order           int                      `json:"order,omitempty" sql:"order"`
+	OrderOption     int                      `json:"order_option,omitempty" sql:"order_option"`
+	Op              string                   `json:"op,omitempty" sql:"op"`
+	Bucket          time.Time                `json:"bucket,omitempty" sql:"bucket"`
+	Count           int                      `json:"count,omitempty" sql:"count"`
+	SumOrder        int                      `json:"sum_order,omitempty" sql:"sum_order"`
+	MinOrder        int                      `json:"min_order,omitempty" sql:"min_order"`
+	MaxOrder        int                      `json:"max_order,omitempty" sql:"max_order"`
+	MeanOrder       float64                  `json:"mean_order,omitempty" sql:"mean_order"`
+	SumOrderOption  int                      `json:"sum_order_option,omitempty" sql:"sum_order_option"`
+	MinOrderOption  int                      `json:"min_order_option,omitempty" sql:"min_order_option"`
+	MaxOrderOption  int                      `json:"max_order_option,omitempty" sql:"max_order_option"`
+	MeanOrderOption float64                  `json:"mean_order_option,omitempty" sql:"mean_order_option"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TaskGroupBy) Rows(ctx context.Context) ([]*TaskGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TaskGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TaskGroupBy) RowsX(ctx context.Context) []*TaskGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TaskSelect is the builder for selecting fields of Task entities.
 type TaskSelect struct {
 	*TaskQuery
@@ -567,6 +623,9 @@ func (_s *TaskSelect) sqlScan(ctx context.Context, root *TaskQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -681,6 +681,7 @@ func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -1657,11 +1658,11 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -1669,6 +1670,65 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupRow is a typed row that is returned by the UserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type UserGroupRow struct {
+	ID              int             `json:"id,omitempty" sql:"id"`
+	OptionalInt     int             `json:"optional_int,omitempty" sql:"optional_int"`
+	Age             int             `json:"age,omitempty" sql:"age"`
+	Name            string          `json:"name,omitempty" sql:"name"`
+	Last            string          `json:"last,omitempty" sql:"last"`
+	Nickname        string          `json:"nickname,omitempty" sql:"nickname"`
+	Address         string          `json:"address,omitempty" sql:"address"`
+	Phone           string          `json:"phone,omitempty" sql:"phone"`
+	Password        string          `json:"password,omitempty" sql:"password"`
+	Role            user.Role       `json:"role,omitempty" sql:"role"`
+	Employment      user.Employment `json:"employment,omitempty" sql:"employment"`
+	SSOCert         string          `json:"SSOCert,omitempty" sql:"sso_cert"`
+	FilesCount      int             `json:"files_count,omitempty" sql:"files_count"`
+	Count           int             `json:"count,omitempty" sql:"count"`
+	SumOptionalInt  int             `json:"sum_optional_int,omitempty" sql:"sum_optional_int"`
+	MinOptionalInt  int             `json:"min_optional_int,omitempty" sql:"min_optional_int"`
+	MaxOptionalInt  int             `json:"max_optional_int,omitempty" sql:"max_optional_int"`
+	MeanOptionalInt float64         `json:"mean_optional_int,omitempty" sql:"mean_optional_int"`
+	SumAge          int             `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge          int             `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge          int             `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge         float64         `json:"mean_age,omitempty" sql:"mean_age"`
+	SumFilesCount   int             `json:"sum_files_count,omitempty" sql:"sum_files_count"`
+	MinFilesCount   int             `json:"min_files_count,omitempty" sql:"min_files_count"`
+	MaxFilesCount   int             `json:"max_files_count,omitempty" sql:"max_files_count"`
+	MeanFilesCount  float64         `json:"mean_files_count,omitempty" sql:"mean_files_count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupBy) Rows(ctx context.Context) ([]*UserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupBy) RowsX(ctx context.Context) []*UserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserSelect is the builder for selecting fields of User entities.
 type UserSelect struct {
 	*UserQuery
@@ -1704,6 +1764,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
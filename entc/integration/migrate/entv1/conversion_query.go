@@ -280,6 +280,7 @@ func (_q *ConversionQuery) GroupBy(field string, fields ...string) *ConversionGr
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = conversion.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -476,11 +477,11 @@ func (_g *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -488,6 +489,82 @@ func (_g *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery,
 	return sql.ScanSlice(rows, v)
 }
 
+// ConversionGroupRow is a typed row that is returned by the ConversionGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ConversionGroupRow struct {
+	ID                 int     `json:"id,omitempty" sql:"id"`
+	Name               string  `json:"name,omitempty" sql:"name"`
+	Int8ToString       int8    `json:"int8_to_string,omitempty" sql:"int8_to_string"`
+	Uint8ToString      uint8   `json:"uint8_to_string,omitempty" sql:"uint8_to_string"`
+	Int16ToString      int16   `json:"int16_to_string,omitempty" sql:"int16_to_string"`
+	Uint16ToString     uint16  `json:"uint16_to_string,omitempty" sql:"uint16_to_string"`
+	Int32ToString      int32   `json:"int32_to_string,omitempty" sql:"int32_to_string"`
+	Uint32ToString     uint32  `json:"uint32_to_string,omitempty" sql:"uint32_to_string"`
+	Int64ToString      int64   `json:"int64_to_string,omitempty" sql:"int64_to_string"`
+	Uint64ToString     uint64  `json:"uint64_to_string,omitempty" sql:"uint64_to_string"`
+	Count              int     `json:"count,omitempty" sql:"count"`
+	SumInt8ToString    int8    `json:"sum_int8_to_string,omitempty" sql:"sum_int8_to_string"`
+	MinInt8ToString    int8    `json:"min_int8_to_string,omitempty" sql:"min_int8_to_string"`
+	MaxInt8ToString    int8    `json:"max_int8_to_string,omitempty" sql:"max_int8_to_string"`
+	MeanInt8ToString   float64 `json:"mean_int8_to_string,omitempty" sql:"mean_int8_to_string"`
+	SumUint8ToString   uint8   `json:"sum_uint8_to_string,omitempty" sql:"sum_uint8_to_string"`
+	MinUint8ToString   uint8   `json:"min_uint8_to_string,omitempty" sql:"min_uint8_to_string"`
+	MaxUint8ToString   uint8   `json:"max_uint8_to_string,omitempty" sql:"max_uint8_to_string"`
+	MeanUint8ToString  float64 `json:"mean_uint8_to_string,omitempty" sql:"mean_uint8_to_string"`
+	SumInt16ToString   int16   `json:"sum_int16_to_string,omitempty" sql:"sum_int16_to_string"`
+	MinInt16ToString   int16   `json:"min_int16_to_string,omitempty" sql:"min_int16_to_string"`
+	MaxInt16ToString   int16   `json:"max_int16_to_string,omitempty" sql:"max_int16_to_string"`
+	MeanInt16ToString  float64 `json:"mean_int16_to_string,omitempty" sql:"mean_int16_to_string"`
+	SumUint16ToString  uint16  `json:"sum_uint16_to_string,omitempty" sql:"sum_uint16_to_string"`
+	MinUint16ToString  uint16  `json:"min_uint16_to_string,omitempty" sql:"min_uint16_to_string"`
+	MaxUint16ToString  uint16  `json:"max_uint16_to_string,omitempty" sql:"max_uint16_to_string"`
+	MeanUint16ToString float64 `json:"mean_uint16_to_string,omitempty" sql:"mean_uint16_to_string"`
+	SumInt32ToString   int32   `json:"sum_int32_to_string,omitempty" sql:"sum_int32_to_string"`
+	MinInt32ToString   int32   `json:"min_int32_to_string,omitempty" sql:"min_int32_to_string"`
+	MaxInt32ToString   int32   `json:"max_int32_to_string,omitempty" sql:"max_int32_to_string"`
+	MeanInt32ToString  float64 `json:"mean_int32_to_string,omitempty" sql:"mean_int32_to_string"`
+	SumUint32ToString  uint32  `json:"sum_uint32_to_string,omitempty" sql:"sum_uint32_to_string"`
+	MinUint32ToString  uint32  `json:"min_uint32_to_string,omitempty" sql:"min_uint32_to_string"`
+	MaxUint32ToString  uint32  `json:"max_uint32_to_string,omitempty" sql:"max_uint32_to_string"`
+	MeanUint32ToString float64 `json:"mean_uint32_to_string,omitempty" sql:"mean_uint32_to_string"`
+	SumInt64ToString   int64   `json:"sum_int64_to_string,omitempty" sql:"sum_int64_to_string"`
+	MinInt64ToString   int64   `json:"min_int64_to_string,omitempty" sql:"min_int64_to_string"`
+	MaxInt64ToString   int64   `json:"max_int64_to_string,omitempty" sql:"max_int64_to_string"`
+	MeanInt64ToString  float64 `json:"mean_int64_to_string,omitempty" sql:"mean_int64_to_string"`
+	SumUint64ToString  uint64  `json:"sum_uint64_to_string,omitempty" sql:"sum_uint64_to_string"`
+	MinUint64ToString  uint64  `json:"min_uint64_to_string,omitempty" sql:"min_uint64_to_string"`
+	MaxUint64ToString  uint64  `json:"max_uint64_to_string,omitempty" sql:"max_uint64_to_string"`
+	MeanUint64ToString float64 `json:"mean_uint64_to_string,omitempty" sql:"mean_uint64_to_string"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ConversionGroupBy) Rows(ctx context.Context) ([]*ConversionGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ConversionGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ConversionGroupBy) RowsX(ctx context.Context) []*ConversionGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ConversionSelect is the builder for selecting fields of Conversion entities.
 type ConversionSelect struct {
 	*ConversionQuery
@@ -523,6 +600,9 @@ func (_s *ConversionSelect) sqlScan(ctx context.Context, root *ConversionQuery,
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
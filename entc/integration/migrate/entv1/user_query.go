@@ -423,6 +423,7 @@ func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -782,11 +783,11 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -794,6 +795,57 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupRow is a typed row that is returned by the UserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type UserGroupRow struct {
+	ID           int        `json:"id,omitempty" sql:"oid"`
+	Age          int32      `json:"age,omitempty" sql:"age"`
+	Name         string     `json:"name,omitempty" sql:"name"`
+	Description  string     `json:"description,omitempty" sql:"description"`
+	Nickname     string     `json:"nickname,omitempty" sql:"nickname"`
+	Address      string     `json:"address,omitempty" sql:"address"`
+	Renamed      string     `json:"renamed,omitempty" sql:"renamed"`
+	OldToken     string     `json:"old_token,omitempty" sql:"old_token"`
+	Blob         []byte     `json:"blob,omitempty" sql:"blob"`
+	State        user.State `json:"state,omitempty" sql:"state"`
+	Status       string     `json:"status,omitempty" sql:"status"`
+	Workplace    string     `json:"workplace,omitempty" sql:"workplace"`
+	DropOptional string     `json:"drop_optional,omitempty" sql:"drop_optional"`
+	Count        int        `json:"count,omitempty" sql:"count"`
+	SumAge       int32      `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge       int32      `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge       int32      `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge      float64    `json:"mean_age,omitempty" sql:"mean_age"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupBy) Rows(ctx context.Context) ([]*UserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupBy) RowsX(ctx context.Context) []*UserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserSelect is the builder for selecting fields of User entities.
 type UserSelect struct {
 	*UserQuery
@@ -829,6 +881,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
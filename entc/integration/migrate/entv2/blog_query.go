@@ -317,6 +317,7 @@ func (_q *BlogQuery) GroupBy(field string, fields ...string) *BlogGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = blog.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -556,11 +557,11 @@ func (_g *BlogGroupBy) sqlScan(ctx context.Context, root *BlogQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -568,6 +569,46 @@ func (_g *BlogGroupBy) sqlScan(ctx context.Context, root *BlogQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// BlogGroupRow is a typed row that is returned by the BlogGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type BlogGroupRow struct {
+	ID      int     `json:"id,omitempty" sql:"id"`
+	Oid     int     `json:"oid,omitempty" sql:"oid"`
+	Count   int     `json:"count,omitempty" sql:"count"`
+	SumOid  int     `json:"sum_oid,omitempty" sql:"sum_oid"`
+	MinOid  int     `json:"min_oid,omitempty" sql:"min_oid"`
+	MaxOid  int     `json:"max_oid,omitempty" sql:"max_oid"`
+	MeanOid float64 `json:"mean_oid,omitempty" sql:"mean_oid"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *BlogGroupBy) Rows(ctx context.Context) ([]*BlogGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*BlogGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *BlogGroupBy) RowsX(ctx context.Context) []*BlogGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // BlogSelect is the builder for selecting fields of Blog entities.
 type BlogSelect struct {
 	*BlogQuery
@@ -603,6 +644,9 @@ func (_s *BlogSelect) sqlScan(ctx context.Context, root *BlogQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
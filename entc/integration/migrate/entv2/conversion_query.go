@@ -280,6 +280,7 @@ func (_q *ConversionQuery) GroupBy(field string, fields ...string) *ConversionGr
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = conversion.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -476,11 +477,11 @@ func (_g *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -488,6 +489,50 @@ func (_g *ConversionGroupBy) sqlScan(ctx context.Context, root *ConversionQuery,
 	return sql.ScanSlice(rows, v)
 }
 
+// ConversionGroupRow is a typed row that is returned by the ConversionGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ConversionGroupRow struct {
+	ID             int    `json:"id,omitempty" sql:"id"`
+	Name           string `json:"name,omitempty" sql:"name"`
+	Int8ToString   string `json:"int8_to_string,omitempty" sql:"int8_to_string"`
+	Uint8ToString  string `json:"uint8_to_string,omitempty" sql:"uint8_to_string"`
+	Int16ToString  string `json:"int16_to_string,omitempty" sql:"int16_to_string"`
+	Uint16ToString string `json:"uint16_to_string,omitempty" sql:"uint16_to_string"`
+	Int32ToString  string `json:"int32_to_string,omitempty" sql:"int32_to_string"`
+	Uint32ToString string `json:"uint32_to_string,omitempty" sql:"uint32_to_string"`
+	Int64ToString  string `json:"int64_to_string,omitempty" sql:"int64_to_string"`
+	Uint64ToString string `json:"uint64_to_string,omitempty" sql:"uint64_to_string"`
+	Count          int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ConversionGroupBy) Rows(ctx context.Context) ([]*ConversionGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ConversionGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ConversionGroupBy) RowsX(ctx context.Context) []*ConversionGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ConversionSelect is the builder for selecting fields of Conversion entities.
 type ConversionSelect struct {
 	*ConversionQuery
@@ -523,6 +568,9 @@ func (_s *ConversionSelect) sqlScan(ctx context.Context, root *ConversionQuery,
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
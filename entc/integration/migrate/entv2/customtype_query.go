@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -280,6 +281,7 @@ func (_q *CustomTypeQuery) GroupBy(field string, fields ...string) *CustomTypeGr
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = customtype.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -476,11 +478,11 @@ func (_g *CustomTypeGroupBy) sqlScan(ctx context.Context, root *CustomTypeQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -488,6 +490,46 @@ func (_g *CustomTypeGroupBy) sqlScan(ctx context.Context, root *CustomTypeQuery,
 	return sql.ScanSlice(rows, v)
 }
 
+// CustomTypeGroupRow is a typed row that is returned by the CustomTypeGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type CustomTypeGroupRow struct {
+	ID     int       `json:"id,omitempty" sql:"id"`
+	Custom string    `json:"custom,omitempty" sql:"custom"`
+	Tz0    time.Time `json:"tz0,omitempty" sql:"tz0"`
+	Tz3    time.Time `json:"tz3,omitempty" sql:"tz3"`
+	Bucket time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count  int       `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CustomTypeGroupBy) Rows(ctx context.Context) ([]*CustomTypeGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CustomTypeGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CustomTypeGroupBy) RowsX(ctx context.Context) []*CustomTypeGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CustomTypeSelect is the builder for selecting fields of CustomType entities.
 type CustomTypeSelect struct {
 	*CustomTypeQuery
@@ -523,6 +565,9 @@ func (_s *CustomTypeSelect) sqlScan(ctx context.Context, root *CustomTypeQuery,
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -280,6 +280,7 @@ func (_q *MediaQuery) GroupBy(field string, fields ...string) *MediaGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = media.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -476,11 +477,11 @@ func (_g *MediaGroupBy) sqlScan(ctx context.Context, root *MediaQuery, v any) er
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -488,6 +489,44 @@ func (_g *MediaGroupBy) sqlScan(ctx context.Context, root *MediaQuery, v any) er
 	return sql.ScanSlice(rows, v)
 }
 
+// MediaGroupRow is a typed row that is returned by the MediaGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type MediaGroupRow struct {
+	ID        int    `json:"id,omitempty" sql:"id"`
+	Source    string `json:"source,omitempty" sql:"source"`
+	SourceURI string `json:"source_uri,omitempty" sql:"source_uri"`
+	Text      string `json:"text,omitempty" sql:"text"`
+	Count     int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *MediaGroupBy) Rows(ctx context.Context) ([]*MediaGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*MediaGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *MediaGroupBy) RowsX(ctx context.Context) []*MediaGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MediaSelect is the builder for selecting fields of Media entities.
 type MediaSelect struct {
 	*MediaQuery
@@ -523,6 +562,9 @@ func (_s *MediaSelect) sqlScan(ctx context.Context, root *MediaQuery, v any) err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -389,6 +390,7 @@ func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -736,11 +738,11 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -748,6 +750,68 @@ func (_g *UserGroupBy) sqlScan(ctx context.Context, root *UserQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// UserGroupRow is a typed row that is returned by the UserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type UserGroupRow struct {
+	ID           int            `json:"id,omitempty" sql:"oid"`
+	MixedString  string         `json:"mixed_string,omitempty" sql:"mixed_string"`
+	MixedEnum    user.MixedEnum `json:"mixed_enum,omitempty" sql:"mixed_enum"`
+	Active       bool           `json:"active,omitempty" sql:"active"`
+	Age          int            `json:"age,omitempty" sql:"age"`
+	Name         string         `json:"name,omitempty" sql:"name"`
+	Description  string         `json:"description,omitempty" sql:"description"`
+	Nickname     string         `json:"nickname,omitempty" sql:"nickname"`
+	Phone        string         `json:"phone,omitempty" sql:"phone"`
+	Buffer       []byte         `json:"buffer,omitempty" sql:"buffer"`
+	Title        string         `json:"title,omitempty" sql:"title"`
+	NewName      string         `json:"new_name,omitempty" sql:"renamed"`
+	NewToken     string         `json:"new_token,omitempty" sql:"new_token"`
+	Blob         []byte         `json:"blob,omitempty" sql:"blob"`
+	State        user.State     `json:"state,omitempty" sql:"state"`
+	Status       user.Status    `json:"status,omitempty" sql:"status"`
+	Workplace    string         `json:"workplace,omitempty" sql:"workplace"`
+	Roles        []string       `json:"roles,omitempty" sql:"roles"`
+	DefaultExpr  string         `json:"default_expr,omitempty" sql:"default_expr"`
+	DefaultExprs string         `json:"default_exprs,omitempty" sql:"default_exprs"`
+	CreatedAt    time.Time      `json:"created_at,omitempty" sql:"created_at"`
+	DropOptional string         `json:"drop_optional,omitempty" sql:"drop_optional"`
+	Bucket       time.Time      `json:"bucket,omitempty" sql:"bucket"`
+	Count        int            `json:"count,omitempty" sql:"count"`
+	SumAge       int            `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge       int            `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge       int            `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge      float64        `json:"mean_age,omitempty" sql:"mean_age"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserGroupBy) Rows(ctx context.Context) ([]*UserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserGroupBy) RowsX(ctx context.Context) []*UserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserSelect is the builder for selecting fields of User entities.
 type UserSelect struct {
 	*UserQuery
@@ -783,6 +847,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
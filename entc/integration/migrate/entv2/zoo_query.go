@@ -268,6 +268,7 @@ func (_q *ZooQuery) GroupBy(field string, fields ...string) *ZooGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = zoo.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -454,11 +455,11 @@ func (_g *ZooGroupBy) sqlScan(ctx context.Context, root *ZooQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -466,6 +467,41 @@ func (_g *ZooGroupBy) sqlScan(ctx context.Context, root *ZooQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// ZooGroupRow is a typed row that is returned by the ZooGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ZooGroupRow struct {
+	ID    int `json:"id,omitempty" sql:"id"`
+	Count int `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ZooGroupBy) Rows(ctx context.Context) ([]*ZooGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ZooGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ZooGroupBy) RowsX(ctx context.Context) []*ZooGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ZooSelect is the builder for selecting fields of Zoo entities.
 type ZooSelect struct {
 	*ZooQuery
@@ -501,6 +537,9 @@ func (_s *ZooSelect) sqlScan(ctx context.Context, root *ZooQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -210,6 +210,7 @@ func (_q *CleanUserQuery) GroupBy(field string, fields ...string) *CleanUserGrou
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = cleanuser.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -304,6 +305,8 @@ func (_q *CleanUserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	} else {
+		_spec.Unique = false
 	}
 	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
@@ -425,11 +428,11 @@ func (_g *CleanUserGroupBy) sqlScan(ctx context.Context, root *CleanUserQuery, v
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -437,6 +440,41 @@ func (_g *CleanUserGroupBy) sqlScan(ctx context.Context, root *CleanUserQuery, v
 	return sql.ScanSlice(rows, v)
 }
 
+// CleanUserGroupRow is a typed row that is returned by the CleanUserGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CleanUserGroupRow struct {
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CleanUserGroupBy) Rows(ctx context.Context) ([]*CleanUserGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CleanUserGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CleanUserGroupBy) RowsX(ctx context.Context) []*CleanUserGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CleanUserSelect is the builder for selecting fields of CleanUser entities.
 type CleanUserSelect struct {
 	*CleanUserQuery
@@ -472,6 +510,9 @@ func (_s *CleanUserSelect) sqlScan(ctx context.Context, root *CleanUserQuery, v
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -360,6 +361,7 @@ func (_q *FriendshipQuery) GroupBy(field string, fields ...string) *FriendshipGr
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = friendship.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -660,11 +662,11 @@ func (_g *FriendshipGroupBy) sqlScan(ctx context.Context, root *FriendshipQuery,
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -672,6 +674,59 @@ func (_g *FriendshipGroupBy) sqlScan(ctx context.Context, root *FriendshipQuery,
 	return sql.ScanSlice(rows, v)
 }
 
+// FriendshipGroupRow is a typed row that is returned by the FriendshipGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type FriendshipGroupRow struct {
+	ID           int       `json:"id,omitempty" sql:"id"`
+	Weight       int       `json:"weight,omitempty" sql:"weight"`
+	CreatedAt    time.Time `json:"created_at,omitempty" sql:"created_at"`
+	UserID       int       `json:"user_id,omitempty" sql:"user_id"`
+	FriendID     int       `json:"friend_id,omitempty" sql:"friend_id"`
+	Bucket       time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count        int       `json:"count,omitempty" sql:"count"`
+	SumWeight    int       `json:"sum_weight,omitempty" sql:"sum_weight"`
+	MinWeight    int       `json:"min_weight,omitempty" sql:"min_weight"`
+	MaxWeight    int       `json:"max_weight,omitempty" sql:"max_weight"`
+	MeanWeight   float64   `json:"mean_weight,omitempty" sql:"mean_weight"`
+	SumUserID    int       `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID    int       `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID    int       `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID   float64   `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumFriendID  int       `json:"sum_friend_id,omitempty" sql:"sum_friend_id"`
+	MinFriendID  int       `json:"min_friend_id,omitempty" sql:"min_friend_id"`
+	MaxFriendID  int       `json:"max_friend_id,omitempty" sql:"max_friend_id"`
+	MeanFriendID float64   `json:"mean_friend_id,omitempty" sql:"mean_friend_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FriendshipGroupBy) Rows(ctx context.Context) ([]*FriendshipGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FriendshipGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FriendshipGroupBy) RowsX(ctx context.Context) []*FriendshipGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FriendshipSelect is the builder for selecting fields of Friendship entities.
 type FriendshipSelect struct {
 	*FriendshipQuery
@@ -707,6 +762,9 @@ func (_s *FriendshipSelect) sqlScan(ctx context.Context, root *FriendshipQuery,
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -360,6 +360,7 @@ func (_q *ParentQuery) GroupBy(field string, fields ...string) *ParentGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = parent.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -660,11 +661,11 @@ func (_g *ParentGroupBy) sqlScan(ctx context.Context, root *ParentQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -672,6 +673,52 @@ func (_g *ParentGroupBy) sqlScan(ctx context.Context, root *ParentQuery, v any)
 	return sql.ScanSlice(rows, v)
 }
 
+// ParentGroupRow is a typed row that is returned by the ParentGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type ParentGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	ByAdoption   bool    `json:"by_adoption,omitempty" sql:"by_adoption"`
+	UserID       int     `json:"user_id,omitempty" sql:"user_id"`
+	ParentID     int     `json:"parent_id,omitempty" sql:"parent_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumUserID    int     `json:"sum_user_id,omitempty" sql:"sum_user_id"`
+	MinUserID    int     `json:"min_user_id,omitempty" sql:"min_user_id"`
+	MaxUserID    int     `json:"max_user_id,omitempty" sql:"max_user_id"`
+	MeanUserID   float64 `json:"mean_user_id,omitempty" sql:"mean_user_id"`
+	SumParentID  int     `json:"sum_parent_id,omitempty" sql:"sum_parent_id"`
+	MinParentID  int     `json:"min_parent_id,omitempty" sql:"min_parent_id"`
+	MaxParentID  int     `json:"max_parent_id,omitempty" sql:"max_parent_id"`
+	MeanParentID float64 `json:"mean_parent_id,omitempty" sql:"mean_parent_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *ParentGroupBy) Rows(ctx context.Context) ([]*ParentGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*ParentGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *ParentGroupBy) RowsX(ctx context.Context) []*ParentGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // ParentSelect is the builder for selecting fields of Parent entities.
 type ParentSelect struct {
 	*ParentQuery
@@ -707,6 +754,9 @@ func (_s *ParentSelect) sqlScan(ctx context.Context, root *ParentQuery, v any) e
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -21,6 +21,7 @@ import (
 	"entgo.io/ent/entc/integration/privacy/ent/team"
 	"entgo.io/ent/entc/integration/privacy/ent/user"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // TaskQuery is the builder for querying Task entities.
@@ -355,6 +356,7 @@ func (_q *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = task.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -676,11 +678,11 @@ func (_g *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -688,6 +690,45 @@ func (_g *TaskGroupBy) sqlScan(ctx context.Context, root *TaskQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// TaskGroupRow is a typed row that is returned by the TaskGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type TaskGroupRow struct {
+	ID          int         `json:"id,omitempty" sql:"id"`
+	Title       string      `json:"title,omitempty" sql:"title"`
+	Description string      `json:"description,omitempty" sql:"description"`
+	Status      task.Status `json:"status,omitempty" sql:"status"`
+	UUID        uuid.UUID   `json:"uuid,omitempty" sql:"uuid"`
+	Count       int         `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TaskGroupBy) Rows(ctx context.Context) ([]*TaskGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TaskGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TaskGroupBy) RowsX(ctx context.Context) []*TaskGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TaskSelect is the builder for selecting fields of Task entities.
 type TaskSelect struct {
 	*TaskQuery
@@ -723,6 +764,9 @@ func (_s *TaskSelect) sqlScan(ctx context.Context, root *TaskQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
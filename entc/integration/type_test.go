@@ -111,6 +111,14 @@ func Types(t *testing.T, client *ent.Client) {
 	require.Equal(&schema.Pair{K: []byte("K"), V: []byte("V")}, ft.NilPair)
 	require.EqualValues([]string{"foo", "bar", "baz"}, ft.StringArray)
 	require.Equal("1000", ft.BigInt.String())
+	// Nillable fields with pointer types are copied as is.
+	clone := client.FieldType.Clone(ctx, ft).SaveX(ctx)
+	require.NotEqual(ft.ID, clone.ID)
+	require.Equal("not-default", clone.NullStr.String)
+	require.Equal("localhost", clone.NullLink.String())
+	require.Equal(&schema.Pair{K: []byte("K"), V: []byte("V")}, clone.NilPair)
+	require.Equal(int64(math.MinInt64), *clone.NillableInt64)
+	require.Equal(http.Dir("ndir"), *clone.Ndir)
 	exists, err := client.FieldType.Query().Where(fieldtype.DurationLT(time.Hour * 2)).Exist(ctx)
 	require.NoError(err)
 	require.True(exists)
@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserStats holds the number of pets per user.
+type UserStats struct {
+	ent.View
+}
+
+// Annotations of the UserStats.
+func (UserStats) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.MaterializedView("SELECT owner_id AS user_id, COUNT(*) AS pets FROM pets WHERE owner_id IS NOT NULL GROUP BY owner_id"),
+	}
+}
+
+// Fields of the UserStats.
+func (UserStats) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("pets"),
+	}
+}
+
+// Edges of the UserStats.
+func (UserStats) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("stats").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the UserStats.
+func (UserStats) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").
+			Unique(),
+	}
+}
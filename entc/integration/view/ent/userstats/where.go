@@ -0,0 +1,121 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by ent, DO NOT EDIT.
+
+package userstats
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/view/ent/predicate"
+)
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldEQ(FieldUserID, v))
+}
+
+// Pets applies equality check predicate on the "pets" field. It's identical to PetsEQ.
+func Pets(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldEQ(FieldPets, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// PetsEQ applies the EQ predicate on the "pets" field.
+func PetsEQ(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldEQ(FieldPets, v))
+}
+
+// PetsNEQ applies the NEQ predicate on the "pets" field.
+func PetsNEQ(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldNEQ(FieldPets, v))
+}
+
+// PetsIn applies the In predicate on the "pets" field.
+func PetsIn(vs ...int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldIn(FieldPets, vs...))
+}
+
+// PetsNotIn applies the NotIn predicate on the "pets" field.
+func PetsNotIn(vs ...int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldNotIn(FieldPets, vs...))
+}
+
+// PetsGT applies the GT predicate on the "pets" field.
+func PetsGT(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldGT(FieldPets, v))
+}
+
+// PetsGTE applies the GTE predicate on the "pets" field.
+func PetsGTE(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldGTE(FieldPets, v))
+}
+
+// PetsLT applies the LT predicate on the "pets" field.
+func PetsLT(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldLT(FieldPets, v))
+}
+
+// PetsLTE applies the LTE predicate on the "pets" field.
+func PetsLTE(v int) predicate.UserStats {
+	return predicate.UserStats(sql.FieldLTE(FieldPets, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, UserColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserStats {
+	return predicate.UserStats(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserStats) predicate.UserStats {
+	return predicate.UserStats(sql.NotPredicates(p))
+}
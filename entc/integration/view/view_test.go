@@ -0,0 +1,86 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package view
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/entc/integration/view/ent"
+	"entgo.io/ent/entc/integration/view/ent/user"
+	"entgo.io/ent/entc/integration/view/ent/userstats"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializedView(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:materialized?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	client.Pet.CreateBulk(
+		client.Pet.Create().SetName("pedro").SetOwner(a8m),
+		client.Pet.Create().SetName("xabi").SetOwner(a8m),
+	).ExecX(ctx)
+	require.Zero(t, client.UserStats.Query().CountX(ctx), "materialized view is populated only on refresh")
+
+	require.NoError(t, client.UserStats.Refresh(ctx, false))
+	stats := client.UserStats.Query().OnlyX(ctx)
+	require.Equal(t, a8m.ID, stats.UserID)
+	require.Equal(t, 2, stats.Pets)
+
+	nat := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(nat).ExecX(ctx)
+	require.NoError(t, client.UserStats.Refresh(ctx, false))
+	all := client.UserStats.Query().Order(userstats.ByUserID()).AllX(ctx)
+	require.Len(t, all, 2)
+	require.Equal(t, 2, all[0].Pets)
+	require.Equal(t, 1, all[1].Pets)
+
+	// Running the migration again is a no-op for the emulated view.
+	require.NoError(t, client.Schema.Create(ctx))
+	require.Equal(t, 2, client.UserStats.Query().CountX(ctx))
+}
+
+func TestViewEdges(t *testing.T) {
+	client, err := ent.Open("sqlite3", "file:edges?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nat := client.User.Create().SetName("nati").SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).ExecX(ctx)
+	require.NoError(t, client.UserStats.Refresh(ctx, false))
+
+	// Traversals to the view.
+	require.Equal(t, 1, a8m.QueryStats().CountX(ctx))
+	require.True(t, a8m.QueryStats().ExistX(ctx))
+	require.Zero(t, nat.QueryStats().CountX(ctx))
+	require.False(t, nat.QueryStats().ExistX(ctx))
+	require.Equal(t, 1, client.User.Query().QueryStats().CountX(ctx))
+	require.True(t, client.User.Query().Where(user.Name("a8m")).QueryStats().ExistX(ctx))
+	require.False(t, client.User.Query().Where(user.Name("nati")).QueryStats().ExistX(ctx))
+
+	// Traversals from the view.
+	stats := a8m.QueryStats().OnlyX(ctx)
+	require.Equal(t, 1, stats.QueryUser().CountX(ctx))
+	require.True(t, stats.QueryUser().ExistX(ctx))
+	require.Equal(t, 1, client.UserStats.Query().QueryUser().CountX(ctx))
+	require.Equal(t, a8m.ID, client.UserStats.Query().QueryUser().OnlyIDX(ctx))
+	require.Equal(t, 1, client.UserStats.Query().Where(userstats.HasUserWith(user.Name("a8m"))).CountX(ctx))
+
+	// Views are counted by their selected fields.
+	client.Pet.Create().SetName("luna").SetOwner(nat).ExecX(ctx)
+	require.NoError(t, client.UserStats.Refresh(ctx, false))
+	require.Equal(t, 2, client.UserStats.Query().CountX(ctx))
+	require.Equal(t, 1, client.UserStats.Query().Unique(true).Select(userstats.FieldPets).CountX(ctx))
+	require.Equal(t, 2, client.User.Query().QueryStats().CountX(ctx))
+}
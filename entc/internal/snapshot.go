@@ -245,3 +245,10 @@ func trim(line []byte) ([]byte, error) {
 	}
 	return []byte(l), nil
 }
+
+// ParseSnapshot parses the snapshot file content that was read from the
+// given path, and returns its schema snapshot. Merge conflicts in the file
+// are resolved in the same way they are resolved by Restore.
+func ParseSnapshot(path string, buf []byte) (*gen.Snapshot, error) {
+	return (&Snapshot{Path: path}).parseSnapshot(buf)
+}
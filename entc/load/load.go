@@ -58,6 +58,19 @@ type (
 		// BuildFlags are forwarded to the package.Config when
 		// loading the schema package.
 		BuildFlags []string
+		// Cache, if not nil, holds the information of the schema package between loads
+		// (e.g. in watch mode). The package is type-checked again only if the type
+		// declarations in its files were changed since it was cached.
+		Cache *Cache
+	}
+
+	// A Cache holds the information of a schema package that was collected by type-checking
+	// it, for reusing it in subsequent loads of the package. The zero value is an empty cache.
+	Cache struct {
+		spec  SchemaSpec
+		dir   string
+		decls string
+		names []string
 	}
 )
 
@@ -115,9 +128,13 @@ var entInterface = reflect.TypeOf(struct{ ent.Interface }{}).Field(0).Type
 
 // load the ent/schema info.
 func (c *Config) load() (*SchemaSpec, map[string]string, error) {
+	if spec, names, ok := c.Cache.lookup(); ok {
+		c.setNames(names)
+		return spec, names, nil
+	}
 	pkgs, err := packages.Load(&packages.Config{
 		BuildFlags: c.BuildFlags,
-		Mode:       packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedModule,
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedModule,
 	}, c.Path, entInterface.PkgPath())
 	if err != nil {
 		return nil, nil, fmt.Errorf("loading package: %w", err)
@@ -157,12 +174,102 @@ func (c *Config) load() (*SchemaSpec, map[string]string, error) {
 		p := pkg.Fset.Position(spec.Pos())
 		names[k.Name] = fmt.Sprintf("%s:%d", p.Filename, p.Line)
 	}
+	c.setNames(names)
+	spec := &SchemaSpec{PkgPath: pkg.PkgPath, Module: pkg.Module}
+	if c.Cache != nil && len(pkg.GoFiles) > 0 {
+		c.Cache.store(spec, filepath.Dir(pkg.GoFiles[0]), names)
+	}
+	return spec, names, nil
+}
+
+// setNames sets the schema names to load, if they were not set by the user.
+func (c *Config) setNames(names map[string]string) {
 	if len(c.Names) == 0 {
 		c.Names = slices.Sorted(maps.Keys(names))
 	} else {
 		sort.Strings(c.Names)
 	}
-	return &SchemaSpec{PkgPath: pkg.PkgPath, Module: pkg.Module}, names, nil
+}
+
+// lookup returns the cached information of the schema package, and the current
+// positions of its schemas, if the type declarations of the package were not changed.
+func (c *Cache) lookup() (*SchemaSpec, map[string]string, bool) {
+	if c == nil || c.dir == "" {
+		return nil, nil, false
+	}
+	decls, pos, err := typeDecls(c.dir)
+	if err != nil || decls != c.decls {
+		return nil, nil, false
+	}
+	names := make(map[string]string, len(c.names))
+	for _, n := range c.names {
+		names[n] = pos[n]
+	}
+	spec := c.spec
+	return &spec, names, true
+}
+
+// store caches the information of the schema package that resides in the given directory.
+func (c *Cache) store(spec *SchemaSpec, dir string, names map[string]string) {
+	*c = Cache{spec: *spec, dir: dir, names: slices.Sorted(maps.Keys(names))}
+	decls, _, err := typeDecls(dir)
+	if err != nil {
+		c.dir = ""
+		return
+	}
+	c.decls = decls
+}
+
+// typeDecls returns the imports and the type declarations of the Go files in the given
+// directory, without their positions, and the positions of the declared types. Files are
+// not filtered by build constraints, and types that are declared more than once (e.g. in
+// files with different build tags) invalidate the result, as their position is ambiguous.
+func typeDecls(dir string) (string, map[string]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		b    strings.Builder
+		fset = token.NewFileSet()
+		pos  = make(map[string]string)
+	)
+	for _, path := range matches {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		fmt.Fprintf(&b, "%s package %s\n", filepath.Base(path), f.Name.Name)
+		for _, imp := range f.Imports {
+			var name string
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			fmt.Fprintf(&b, "import %s %s\n", name, imp.Path.Value)
+		}
+		for _, d := range f.Decls {
+			g, ok := d.(*ast.GenDecl)
+			if !ok || g.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range g.Specs {
+				ts := s.(*ast.TypeSpec)
+				if _, ok := pos[ts.Name.Name]; ok {
+					return "", nil, fmt.Errorf("type %s is declared more than once", ts.Name.Name)
+				}
+				p := fset.Position(ts.Pos())
+				pos[ts.Name.Name] = fmt.Sprintf("%s:%d", p.Filename, p.Line)
+				fmt.Fprintf(&b, "type %s %s\n", ts.Name.Name, types.ExprString(ts.Type))
+				if ts.TypeParams != nil {
+					fmt.Fprintf(&b, "params %s\n", types.ExprString(&ast.FuncType{Params: ts.TypeParams}))
+				}
+			}
+		}
+	}
+	return b.String(), pos, nil
 }
 
 func (c *Config) loadError(perr packages.Error) (err error) {
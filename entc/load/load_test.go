@@ -5,6 +5,9 @@
 package load
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"entgo.io/ent/schema/field"
@@ -24,6 +27,47 @@ func TestLoad(t *testing.T) {
 	require.Equal(t, "User", spec.Schemas[2].Name)
 }
 
+func TestLoadCache(t *testing.T) {
+	dir, err := os.MkdirTemp("testdata", "cache")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	src, err := os.ReadFile("testdata/valid/schema.go")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "schema.go")
+	require.NoError(t, os.WriteFile(path, src, 0644))
+
+	cache := &Cache{}
+	spec, err := (&Config{Path: "./" + dir, Cache: cache}).Load()
+	require.NoError(t, err)
+	require.Len(t, spec.Schemas, 3)
+	_, _, ok := cache.lookup()
+	require.True(t, ok, "type declarations were not changed")
+
+	// Changing the schema fields does not invalidate the cache.
+	src = []byte(strings.Replace(string(src), `field.Int("age"),`, "// Age of the user.\n\t\tfield.Int(\"age\"),\n\t\tfield.Bool(\"active\"),", 1))
+	require.NoError(t, os.WriteFile(path, src, 0644))
+	_, names, ok := cache.lookup()
+	require.True(t, ok)
+	require.Len(t, names, 3)
+	spec, err = (&Config{Path: "./" + dir, Cache: cache}).Load()
+	require.NoError(t, err)
+	require.Equal(t, "User", spec.Schemas[2].Name)
+	require.Len(t, spec.Schemas[2].Fields, 3)
+	require.Equal(t, "Group", spec.Schemas[0].Name)
+	require.True(t, strings.HasSuffix(spec.Schemas[0].Pos, "schema.go:27"), "positions are computed by parsing the files")
+
+	// Adding a type invalidates the cache.
+	src = append(src, "\n// Pet holds the pet schema.\ntype Pet struct {\n\tent.Schema\n}\n"...)
+	require.NoError(t, os.WriteFile(path, src, 0644))
+	_, _, ok = cache.lookup()
+	require.False(t, ok)
+	spec, err = (&Config{Path: "./" + dir, Cache: cache}).Load()
+	require.NoError(t, err)
+	require.Len(t, spec.Schemas, 4)
+	_, _, ok = cache.lookup()
+	require.True(t, ok)
+}
+
 func TestLoadWrongPath(t *testing.T) {
 	cfg := &Config{Path: "./boring"}
 	plg, err := cfg.Load()
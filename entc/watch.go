@@ -0,0 +1,255 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// WatchInterval is the interval between two scans of the schema package in watch mode.
+var WatchInterval = 500 * time.Millisecond
+
+// A WatchEvent describes a single codegen run in watch mode.
+type WatchEvent struct {
+	// Files holds the schema files that were added, changed or
+	// removed since the previous run. Empty for the initial run.
+	Files []string
+	// Nodes holds the names of the nodes that their templates were
+	// executed. A nil slice means all nodes were generated, and an
+	// empty slice means the schema was not changed.
+	Nodes []string
+	// Recovered indicates that the schema package failed to build and
+	// the generated package was restored from the schema snapshot. In
+	// this case, Err holds the build error that triggered the recovery.
+	Recovered bool
+	// Reverted indicates that the code generation failed, and the files it
+	// wrote were reverted by regenerating the graph of the last successful
+	// run. In this case, Err holds the error of the failed generation.
+	Reverted bool
+	// Err holds the error of the run, if any.
+	Err error
+	// Elapsed is the duration of the run.
+	Elapsed time.Duration
+}
+
+// Watch runs the codegen on the schema path, and then watches the schema
+// package for changes until the context is canceled. On every change, the
+// schema package is reloaded and only the templates of the affected nodes
+// are re-executed. The result of each run, including failures, is passed to
+// the report function and does not stop the watch.
+//
+// The type information of the schema package is cached between runs, and the
+// package is type-checked again only if its type declarations were changed
+// (see load.Cache). Since the schema package is Go code, each reload still
+// builds and runs the loader program, and its unchanged dependencies are served
+// from the Go build cache.
+//
+// If the schema snapshot feature is enabled and the schema package fails to
+// build, the generated package is restored from the snapshot, and the event
+// is reported as recovered. If the code generation fails, the files it wrote
+// are reverted by regenerating the graph of the last successful run, and the
+// event is reported as reverted. In both cases, the next run generates all nodes
+// if the state of the generated package is unknown.
+//
+//	err := entc.Watch(ctx, "./ent/schema", &gen.Config{}, func(e *entc.WatchEvent) {
+//		log.Println(e.Files, e.Err)
+//	})
+func Watch(ctx context.Context, schemaPath string, cfg *gen.Config, report func(*WatchEvent), options ...Option) error {
+	if err := defaultTarget(schemaPath, cfg); err != nil {
+		return err
+	}
+	for _, opt := range options {
+		if err := opt(cfg); err != nil {
+			return err
+		}
+	}
+	if cfg.Storage == nil {
+		driver, err := gen.NewStorage("sql")
+		if err != nil {
+			return err
+		}
+		cfg.Storage = driver
+	}
+	dir, err := schemaDir(schemaPath, cfg.BuildFlags)
+	if err != nil {
+		return err
+	}
+	w := &watcher{path: schemaPath, dir: dir, cfg: cfg}
+	cache := &load.Cache{}
+	w.load = func() (*gen.Graph, error) {
+		return loadGraph(&load.Config{Path: schemaPath, BuildFlags: cfg.BuildFlags, Cache: cache}, cfg)
+	}
+	if w.files, err = w.scan(); err != nil {
+		return err
+	}
+	report(w.run(nil))
+	ticker := time.NewTicker(WatchInterval)
+	defer ticker.Stop()
+	var pending []string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		files, err := w.scan()
+		if err != nil {
+			return err
+		}
+		// Wait until the schema files are stable (no changes
+		// between two scans) before reloading the package.
+		if changed := w.changed(files); len(changed) > 0 {
+			pending = append(pending, changed...)
+			continue
+		}
+		if len(pending) > 0 {
+			report(w.run(pending))
+			pending = nil
+		}
+	}
+}
+
+// watcher holds the state of the watch mode between runs.
+type watcher struct {
+	path  string
+	dir   string
+	cfg   *gen.Config
+	files map[string]time.Time
+	// prev is the graph of the last successful run.
+	prev *gen.Graph
+	// load loads the graph of the schema package.
+	load func() (*gen.Graph, error)
+}
+
+// run loads the schema package and runs the codegen for the affected nodes.
+func (w *watcher) run(files []string) *WatchEvent {
+	start := time.Now()
+	e := &WatchEvent{}
+	if len(files) > 0 {
+		slices.Sort(files)
+		e.Files = slices.Compact(files)
+	}
+	e.Nodes, e.Err = w.gen(e)
+	e.Elapsed = time.Since(start)
+	return e
+}
+
+// gen loads the schema package and generates the affected nodes. The recovery
+// state of the generated package in case of failure is recorded in the event.
+func (w *watcher) gen(e *WatchEvent) ([]string, error) {
+	undo, err := gen.PrepareEnv(w.cfg)
+	if err != nil {
+		return nil, err
+	}
+	graph, err := w.load()
+	if err != nil {
+		rerr := mayRecover(err, w.path, w.cfg)
+		switch {
+		case rerr == nil:
+			// The graph that was restored from the snapshot may not be the
+			// graph of the last run. Hence, the next run generates all nodes.
+			w.prev, e.Recovered = nil, true
+			return nil, err
+		case rerr != err:
+			// The restoration failed, and the generated package may be partially written.
+			w.prev = nil
+		}
+		_ = undo()
+		return nil, rerr
+	}
+	if err := normalizePkg(w.cfg); err != nil {
+		_ = undo()
+		return nil, err
+	}
+	names, all := graph.Affected(w.prev)
+	switch {
+	case all:
+		err = graph.Gen()
+	case len(names) == 0:
+		return []string{}, undo()
+	default:
+		err = graph.GenNodes(names...)
+	}
+	if err != nil {
+		// Revert the files that were written by the failed generation
+		// by regenerating the graph of the last successful run.
+		if w.prev != nil && w.prev.Gen() == nil {
+			e.Reverted = true
+		} else {
+			w.prev = nil
+			_ = undo()
+		}
+		return nil, err
+	}
+	w.prev = graph
+	return names, nil
+}
+
+// scan returns the modification times of the Go files in the schema directory.
+func (w *watcher) scan() (map[string]time.Time, error) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, fmt.Errorf("entc/watch: read schema dir: %w", err)
+	}
+	files := make(map[string]time.Time, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		info, err := e.Info()
+		// File was removed during the scan.
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Join(w.dir, e.Name())] = info.ModTime()
+	}
+	return files, nil
+}
+
+// changed records the given scan and returns the files that were changed since the previous one.
+func (w *watcher) changed(files map[string]time.Time) (changed []string) {
+	for path, t := range files {
+		if prev, ok := w.files[path]; !ok || !prev.Equal(t) {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.files {
+		if _, ok := files[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	w.files = files
+	return changed
+}
+
+// schemaDir returns the directory of the schema package.
+func schemaDir(schemaPath string, buildFlags []string) (string, error) {
+	if fi, err := os.Stat(schemaPath); err == nil && fi.IsDir() {
+		return filepath.Abs(schemaPath)
+	}
+	pkgs, err := packages.Load(&packages.Config{BuildFlags: buildFlags, Mode: packages.NeedName | packages.NeedFiles}, schemaPath)
+	if err != nil {
+		return "", fmt.Errorf("entc/watch: loading package: %w", err)
+	}
+	if len(pkgs) == 0 || len(pkgs[0].GoFiles) == 0 {
+		return "", fmt.Errorf("entc/watch: missing package files for: %s", schemaPath)
+	}
+	return filepath.Dir(pkgs[0].GoFiles[0]), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Gen(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "ent")
+	storage, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	cfg := &gen.Config{
+		Package: "entc/ent",
+		Target:  target,
+		Storage: storage,
+		IDType:  &field.TypeInfo{Type: field.TypeInt},
+	}
+	user := func(fields ...string) *load.Schema {
+		s := &load.Schema{Name: "User"}
+		for _, f := range fields {
+			s.Fields = append(s.Fields, &load.Field{Name: f, Info: &field.TypeInfo{Type: field.TypeString}})
+		}
+		return s
+	}
+	var (
+		loadErr error
+		schemas []*load.Schema
+		w       = &watcher{cfg: cfg}
+	)
+	w.load = func() (*gen.Graph, error) {
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		return gen.NewGraph(cfg, schemas...)
+	}
+	userFile := func() string {
+		b, err := os.ReadFile(filepath.Join(target, "user.go"))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	schemas = []*load.Schema{user("name")}
+	e := w.run(nil)
+	require.NoError(t, e.Err)
+	require.Nil(t, e.Nodes)
+	require.Contains(t, userFile(), `Name holds the value of the "name" field.`)
+	prev := w.prev
+	require.NotNil(t, prev)
+
+	// A generation that fails after writing its files is reverted.
+	failed := false
+	cfg.Hooks = []gen.Hook{
+		func(next gen.Generator) gen.Generator {
+			return gen.GenerateFunc(func(g *gen.Graph) error {
+				if err := next.Generate(g); err != nil || failed {
+					return err
+				}
+				failed = true
+				return errors.New("hook failed")
+			})
+		},
+	}
+	schemas = []*load.Schema{user("name", "nickname")}
+	e = w.run([]string{"user.go"})
+	require.EqualError(t, e.Err, "hook failed")
+	require.True(t, e.Reverted)
+	require.False(t, e.Recovered)
+	require.NotContains(t, userFile(), "Nickname")
+	require.Equal(t, prev, w.prev, "last good graph should be kept")
+
+	// The next run regenerates the changed nodes.
+	e = w.run([]string{"user.go"})
+	require.NoError(t, e.Err)
+	require.Equal(t, []string{"User"}, e.Nodes)
+	require.Contains(t, userFile(), `Nickname holds the value of the "nickname" field.`)
+	prev = w.prev
+
+	// A load error leaves the generated package and the last good graph as is.
+	loadErr = errors.New("user.go:10:2: undefined: x")
+	e = w.run([]string{"user.go"})
+	require.Equal(t, loadErr, e.Err)
+	require.False(t, e.Recovered)
+	require.False(t, e.Reverted)
+	require.Equal(t, prev, w.prev)
+	require.Contains(t, userFile(), `Nickname holds the value of the "nickname" field.`)
+
+	// Without a good graph, a failed generation cannot be reverted,
+	// and the next run generates all nodes.
+	loadErr, failed, w.prev = nil, false, nil
+	schemas = []*load.Schema{user("name")}
+	e = w.run([]string{"user.go"})
+	require.EqualError(t, e.Err, "hook failed")
+	require.False(t, e.Reverted)
+	require.Nil(t, w.prev)
+	e = w.run([]string{"user.go"})
+	require.NoError(t, e.Err)
+	require.Nil(t, e.Nodes)
+}
@@ -0,0 +1,371 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entcore provides generic runtime helpers for the generated builders.
+// It is used by the code that is generated with the "generics" feature flag, in
+// which the execution methods of the query, create, update and delete builders
+// of each entity, the ID and getter methods of its mutation, and the hook and
+// interceptor helpers of the generated package, delegate to this package.
+//
+// The setters of the builders, the construction of their storage specs (e.g.
+// sqlSave), and the fields and edges of the mutations depend on the schema, and
+// are still generated per entity.
+package entcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent"
+)
+
+// Errors holds the errors of a generated package that are
+// returned by the generic cores of its builders.
+type Errors struct {
+	// Pkg is the name of the generated package, used
+	// as a prefix for the returned error messages.
+	Pkg string
+	// NotFound returns the *NotFoundError of the
+	// generated package for the given type label.
+	NotFound func(label string) error
+	// NotSingular returns the *NotSingularError of the
+	// generated package for the given type label.
+	NotSingular func(label string) error
+	// IsNotFound reports if the given error is a
+	// *NotFoundError of the generated package.
+	IsNotFound func(error) bool
+}
+
+// Type is the generic core of the builders of an entity type T with
+// an ID of type ID. Types without a single-field ID use any as ID.
+type Type[T, ID any] struct {
+	// Label is the label of the entity type (e.g. "user").
+	Label string
+	// Errors holds the errors of the generated package.
+	Errors *Errors
+}
+
+// First returns the first entity returned by all. all is expected to be the All
+// method of a query that is limited to one result. A not-found error is returned
+// when no entity was found.
+func (t *Type[T, ID]) First(ctx context.Context, qc *ent.QueryContext, all func(context.Context) ([]T, error)) (v T, err error) {
+	nodes, err := all(SetContextOp(ctx, qc, ent.OpQueryFirst))
+	if err != nil {
+		return v, err
+	}
+	if len(nodes) == 0 {
+		return v, t.Errors.NotFound(t.Label)
+	}
+	return nodes[0], nil
+}
+
+// FirstID returns the first ID returned by ids. ids is expected to be the IDs method
+// of a query that is limited to one result. A not-found error is returned when no ID
+// was found.
+func (t *Type[T, ID]) FirstID(ctx context.Context, qc *ent.QueryContext, ids func(context.Context) ([]ID, error)) (id ID, err error) {
+	vs, err := ids(SetContextOp(ctx, qc, ent.OpQueryFirstID))
+	if err != nil {
+		return id, err
+	}
+	if len(vs) == 0 {
+		return id, t.Errors.NotFound(t.Label)
+	}
+	return vs[0], nil
+}
+
+// Only returns the only entity returned by all. all is expected to be the All method
+// of a query that is limited to two results. A not-singular error is returned when
+// more than one entity was found, and a not-found error when no entity was found.
+func (t *Type[T, ID]) Only(ctx context.Context, qc *ent.QueryContext, all func(context.Context) ([]T, error)) (v T, err error) {
+	nodes, err := all(SetContextOp(ctx, qc, ent.OpQueryOnly))
+	if err != nil {
+		return v, err
+	}
+	return only(t, nodes)
+}
+
+// OnlyID returns the only ID returned by ids. ids is expected to be the IDs method
+// of a query that is limited to two results. A not-singular error is returned when
+// more than one ID was found, and a not-found error when no ID was found.
+func (t *Type[T, ID]) OnlyID(ctx context.Context, qc *ent.QueryContext, ids func(context.Context) ([]ID, error)) (id ID, err error) {
+	vs, err := ids(SetContextOp(ctx, qc, ent.OpQueryOnlyID))
+	if err != nil {
+		return id, err
+	}
+	return only(t, vs)
+}
+
+// only returns the single element of vs, or the error that describes its length.
+func only[T, ID, V any](t *Type[T, ID], vs []V) (v V, err error) {
+	switch len(vs) {
+	case 1:
+		return vs[0], nil
+	case 0:
+		return v, t.Errors.NotFound(t.Label)
+	default:
+		return v, t.Errors.NotSingular(t.Label)
+	}
+}
+
+// Exist reports if first returns an entity. first is expected to be the First
+// method of the query, and it is used for types without a single-field ID.
+func (t *Type[T, ID]) Exist(ctx context.Context, qc *ent.QueryContext, first func(context.Context) (T, error)) (bool, error) {
+	_, err := first(SetContextOp(ctx, qc, ent.OpQueryExist))
+	return t.exist(err)
+}
+
+// ExistID reports if firstID returns an ID. firstID is expected to be the FirstID
+// method of the query.
+func (t *Type[T, ID]) ExistID(ctx context.Context, qc *ent.QueryContext, firstID func(context.Context) (ID, error)) (bool, error) {
+	_, err := firstID(SetContextOp(ctx, qc, ent.OpQueryExist))
+	return t.exist(err)
+}
+
+func (t *Type[T, ID]) exist(err error) (bool, error) {
+	switch {
+	case t.Errors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("%s: check existence: %w", t.Errors.Pkg, err)
+	default:
+		return true, nil
+	}
+}
+
+// FoundX is like X, but it does not panic on not-found errors. It is used
+// by the FirstX and FirstIDX methods of the generated query builders.
+func (t *Type[T, ID]) FoundX(v T, err error) T {
+	if err != nil && !t.Errors.IsNotFound(err) {
+		panic(err)
+	}
+	return v
+}
+
+// FoundIDX is like FoundX, but for IDs.
+func (t *Type[T, ID]) FoundIDX(id ID, err error) ID {
+	if err != nil && !t.Errors.IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// DeleteOne returns the error of the Exec method of a DeleteOne builder
+// from the result of its underlying Delete builder. A not-found error
+// is returned if no entity was deleted.
+func (t *Type[T, ID]) DeleteOne(n int, err error) error {
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return t.Errors.NotFound(t.Label)
+	default:
+		return nil
+	}
+}
+
+// Query sets the given op on the query context, prepares the query using prepare, and
+// executes it using qr wrapped by the given interceptors. It is used by the All and Count
+// methods of the generated query builders. For example:
+//
+//	func (q *UserQuery) Count(ctx context.Context) (int, error) {
+//		return entcore.Query[int](ctx, q.ctx, ent.OpQueryCount, q.prepareQuery, q, querierCount[*UserQuery](), q.inters)
+//	}
+func Query[V ent.Value](ctx context.Context, qc *ent.QueryContext, op string, prepare func(context.Context) error, q ent.Query, qr ent.Querier, inters []ent.Interceptor) (v V, err error) {
+	ctx = SetContextOp(ctx, qc, op)
+	if err := prepare(ctx); err != nil {
+		return v, err
+	}
+	return Intercept[V](ctx, q, qr, inters)
+}
+
+// IDs sets the IDs op on the query context, and scans the IDs that are
+// selected by the query using scan.
+func IDs[ID any](ctx context.Context, qc *ent.QueryContext, scan func(context.Context, any) error) ([]ID, error) {
+	var ids []ID
+	if err := scan(SetContextOp(ctx, qc, ent.OpQueryIDs), &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Querier returns an ent.Querier that executes queries of type Q using exec. An error
+// is returned if the querier is called with a query of a different type.
+func Querier[V ent.Value, Q ent.Query](exec func(context.Context, Q) (V, error)) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, ok := q.(Q)
+		if !ok {
+			return nil, fmt.Errorf("unexpected query type %T", q)
+		}
+		return exec(ctx, query)
+	})
+}
+
+// Intercept executes the query q using qr wrapped by the given interceptors,
+// and returns its result as a value of type V.
+func Intercept[V ent.Value](ctx context.Context, q ent.Query, qr ent.Querier, inters []ent.Interceptor) (v V, err error) {
+	for i := len(inters) - 1; i >= 0; i-- {
+		qr = inters[i].Intercept(qr)
+	}
+	rv, err := qr.Query(ctx, q)
+	if err != nil {
+		return v, err
+	}
+	vt, ok := rv.(V)
+	if !ok {
+		return v, fmt.Errorf("unexpected type %T returned from %T. expected type: %T", vt, q, v)
+	}
+	return vt, nil
+}
+
+// Scan executes the select or group-by query of root using scan wrapped by the given
+// interceptors, and stores its result in v. It is used by the Scan methods of the
+// generated select and group-by builders.
+func Scan[Q ent.Query](ctx context.Context, root Q, scan func(context.Context, Q, any) error, inters []ent.Interceptor, v any) error {
+	rv := reflect.ValueOf(v)
+	var qr ent.Querier = ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, ok := q.(Q)
+		if !ok {
+			return nil, fmt.Errorf("unexpected query type %T", q)
+		}
+		if err := scan(ctx, query, v); err != nil {
+			return nil, err
+		}
+		if k := rv.Kind(); k == reflect.Pointer && rv.Elem().CanInterface() {
+			return rv.Elem().Interface(), nil
+		}
+		return v, nil
+	})
+	for i := len(inters) - 1; i >= 0; i-- {
+		qr = inters[i].Intercept(qr)
+	}
+	vv, err := qr.Query(ctx, root)
+	if err != nil {
+		return err
+	}
+	switch rv2 := reflect.ValueOf(vv); {
+	case rv.IsNil(), rv2.IsNil(), rv.Kind() != reflect.Pointer:
+	case rv.Type() == rv2.Type():
+		rv.Elem().Set(rv2.Elem())
+	case rv.Elem().Type() == rv2.Type():
+		rv.Elem().Set(rv2)
+	}
+	return nil
+}
+
+// WithHooks executes exec wrapped by the given hooks. The mutation that is passed
+// by the hooks is set to the mutation of the builder before exec is called. It is
+// used by the Save and Exec methods of the generated mutation builders.
+func WithHooks[V ent.Value, M any, PM interface {
+	*M
+	ent.Mutation
+}](ctx context.Context, exec func(context.Context) (V, error), mutation PM, hooks []ent.Hook) (value V, err error) {
+	if len(hooks) == 0 {
+		return exec(ctx)
+	}
+	var mut ent.Mutator = ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mutationT, ok := any(m).(PM)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		*mutation = *mutationT
+		return exec(ctx)
+	})
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if hooks[i] == nil {
+			return value, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+		}
+		mut = hooks[i](mut)
+	}
+	v, err := mut.Mutate(ctx, mutation)
+	if err != nil {
+		return value, err
+	}
+	nv, ok := v.(V)
+	if !ok {
+		return value, fmt.Errorf("unexpected node type %T returned from %T", v, mutation)
+	}
+	return nv, nil
+}
+
+// SetContextOp returns a new context with the given QueryContext attached
+// (including its op) in case it does not exist.
+func SetContextOp(ctx context.Context, qc *ent.QueryContext, op string) context.Context {
+	if ent.QueryFromContext(ctx) == nil {
+		qc.Op = op
+		ctx = ent.NewQueryContext(ctx, qc)
+	}
+	return ctx
+}
+
+// X returns v, and panics if err is not nil. It is used by the X
+// methods of the generated builders. For example:
+//
+//	func (q *UserQuery) AllX(ctx context.Context) []*User {
+//		return entcore.X(q.All(ctx))
+//	}
+func X[V any](v V, err error) V {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustExec panics if err is not nil. It is used by the ExecX
+// methods of the generated builders.
+func MustExec(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Exec drops the value returned by a Save method and returns only its
+// error. It is used by the Exec methods of the generated builders.
+func Exec[V any](_ V, err error) error {
+	return err
+}
+
+// Value returns the value stored in p, and reports if it was set.
+// It is used by the getters of the generated mutations.
+func Value[V any](p *V) (v V, exists bool) {
+	if p == nil {
+		return v, false
+	}
+	return *p, true
+}
+
+// MutationIDs returns the IDs of the entities that are matched by a mutation with the given op.
+// id holds the ID of UpdateOne and DeleteOne mutations (if it is known), and query returns the
+// IDs of the entities that match the predicates of the mutation.
+func MutationIDs[ID any](ctx context.Context, op ent.Op, id *ID, query func(context.Context) ([]ID, error)) ([]ID, error) {
+	switch {
+	case op.Is(ent.OpUpdateOne | ent.OpDeleteOne):
+		if id != nil {
+			return []ID{*id}, nil
+		}
+		fallthrough
+	case op.Is(ent.OpUpdate | ent.OpDelete):
+		return query(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", op)
+	}
+}
+
+// OldField returns the old value of a field, extracted by field from the entity that is returned by
+// oldValue. name is the name of the mutation method, and it is used in the returned error messages.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func OldField[T, ID, V any](ctx context.Context, op ent.Op, id *ID, oldValue func(context.Context) (T, error), name string, field func(T) V) (v V, err error) {
+	if !op.Is(ent.OpUpdateOne) {
+		return v, errors.New(name + " is only allowed on UpdateOne operations")
+	}
+	if id == nil || oldValue == nil {
+		return v, errors.New(name + " requires an ID field in the mutation")
+	}
+	old, err := oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for %s: %w", name, err)
+	}
+	return field(old), nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entcore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entcore"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	node             struct{ ID int }
+	notFoundError    struct{ label string }
+	notSingularError struct{ label string }
+)
+
+func (e *notFoundError) Error() string    { return e.label + " not found" }
+func (e *notSingularError) Error() string { return e.label + " not singular" }
+
+var nodes = &entcore.Type[*node, int]{
+	Label: "node",
+	Errors: &entcore.Errors{
+		Pkg:         "ent",
+		NotFound:    func(l string) error { return &notFoundError{l} },
+		NotSingular: func(l string) error { return &notSingularError{l} },
+		IsNotFound: func(err error) bool {
+			var e *notFoundError
+			return errors.As(err, &e)
+		},
+	},
+}
+
+func all(vs ...*node) func(context.Context) ([]*node, error) {
+	return func(context.Context) ([]*node, error) { return vs, nil }
+}
+
+func TestType_First(t *testing.T) {
+	ctx := context.Background()
+	qc := &ent.QueryContext{}
+	n, err := nodes.First(ctx, qc, all(&node{ID: 1}))
+	require.NoError(t, err)
+	require.Equal(t, 1, n.ID)
+	require.Equal(t, ent.OpQueryFirst, qc.Op)
+
+	_, err = nodes.First(ctx, &ent.QueryContext{}, all())
+	require.EqualError(t, err, "node not found")
+	require.Nil(t, nodes.FoundX(nodes.First(ctx, &ent.QueryContext{}, all())))
+	require.Panics(t, func() {
+		nodes.FoundX(nil, errors.New("boom"))
+	})
+
+	// An existing query context is not overridden.
+	qc = &ent.QueryContext{Op: ent.OpQueryCount}
+	_, err = nodes.First(ent.NewQueryContext(ctx, qc), &ent.QueryContext{}, all(&node{}))
+	require.NoError(t, err)
+	require.Equal(t, ent.OpQueryCount, qc.Op)
+}
+
+func TestType_Only(t *testing.T) {
+	ctx := context.Background()
+	n, err := nodes.Only(ctx, &ent.QueryContext{}, all(&node{ID: 1}))
+	require.NoError(t, err)
+	require.Equal(t, 1, n.ID)
+	_, err = nodes.Only(ctx, &ent.QueryContext{}, all())
+	require.EqualError(t, err, "node not found")
+	_, err = nodes.Only(ctx, &ent.QueryContext{}, all(&node{ID: 1}, &node{ID: 2}))
+	require.EqualError(t, err, "node not singular")
+
+	ids := func(context.Context) ([]int, error) { return []int{1, 2}, nil }
+	_, err = nodes.OnlyID(ctx, &ent.QueryContext{}, ids)
+	require.EqualError(t, err, "node not singular")
+	id, err := nodes.FirstID(ctx, &ent.QueryContext{}, ids)
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+}
+
+func TestType_Exist(t *testing.T) {
+	ctx := context.Background()
+	exist, err := nodes.Exist(ctx, &ent.QueryContext{}, func(ctx context.Context) (*node, error) {
+		return nodes.First(ctx, &ent.QueryContext{}, all())
+	})
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	qc := &ent.QueryContext{}
+	exist, err = nodes.ExistID(ctx, qc, func(ctx context.Context) (int, error) {
+		return nodes.FirstID(ctx, &ent.QueryContext{}, func(context.Context) ([]int, error) { return []int{1}, nil })
+	})
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, ent.OpQueryExist, qc.Op)
+
+	_, err = nodes.ExistID(ctx, &ent.QueryContext{}, func(context.Context) (int, error) {
+		return 0, errors.New("boom")
+	})
+	require.EqualError(t, err, "ent: check existence: boom")
+}
+
+func TestType_DeleteOne(t *testing.T) {
+	require.NoError(t, nodes.DeleteOne(1, nil))
+	require.EqualError(t, nodes.DeleteOne(0, nil), "node not found")
+	require.EqualError(t, nodes.DeleteOne(0, errors.New("boom")), "boom")
+}
+
+func TestOldField(t *testing.T) {
+	ctx := context.Background()
+	id := 1
+	old := func(context.Context) (*node, error) { return &node{ID: id}, nil }
+	field := func(n *node) int { return n.ID }
+	v, err := entcore.OldField(ctx, ent.OpUpdateOne, &id, old, "OldID", field)
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	_, err = entcore.OldField(ctx, ent.OpUpdate, &id, old, "OldID", field)
+	require.EqualError(t, err, "OldID is only allowed on UpdateOne operations")
+	_, err = entcore.OldField(ctx, ent.OpUpdateOne, (*int)(nil), old, "OldID", field)
+	require.EqualError(t, err, "OldID requires an ID field in the mutation")
+	_, err = entcore.OldField(ctx, ent.OpUpdateOne, &id, func(context.Context) (*node, error) {
+		return nil, errors.New("boom")
+	}, "OldID", field)
+	require.EqualError(t, err, "querying old value for OldID: boom")
+}
+
+func TestHelpers(t *testing.T) {
+	v, ok := entcore.Value[int](nil)
+	require.False(t, ok)
+	require.Zero(t, v)
+	v, ok = entcore.Value(&[]int{1}[0])
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	require.Equal(t, 1, entcore.X(1, nil))
+	require.Panics(t, func() { entcore.X(1, errors.New("boom")) })
+	require.Panics(t, func() { entcore.MustExec(errors.New("boom")) })
+	require.EqualError(t, entcore.Exec(1, errors.New("boom")), "boom")
+}
+
+type (
+	query    struct{ nodes []*node }
+	mutation struct {
+		ent.Mutation
+		name string
+	}
+)
+
+func TestQuery(t *testing.T) {
+	ctx := context.Background()
+	q := &query{nodes: []*node{{ID: 1}, {ID: 2}}}
+	qr := entcore.Querier(func(_ context.Context, q *query) ([]*node, error) {
+		return q.nodes, nil
+	})
+	var calls []string
+	inters := []ent.Interceptor{
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				calls = append(calls, "first")
+				return next.Query(ctx, q)
+			})
+		}),
+		ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				calls = append(calls, "second")
+				return next.Query(ctx, q)
+			})
+		}),
+	}
+	qc := &ent.QueryContext{}
+	prepare := func(ctx context.Context) error {
+		require.Equal(t, ent.OpQueryAll, ent.QueryFromContext(ctx).Op)
+		return nil
+	}
+	vs, err := entcore.Query[[]*node](ctx, qc, ent.OpQueryAll, prepare, q, qr, inters)
+	require.NoError(t, err)
+	require.Equal(t, q.nodes, vs)
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	_, err = entcore.Query[[]*node](ctx, &ent.QueryContext{}, ent.OpQueryAll, func(context.Context) error {
+		return errors.New("boom")
+	}, q, qr, nil)
+	require.EqualError(t, err, "boom")
+	_, err = entcore.Query[int](ctx, &ent.QueryContext{}, ent.OpQueryCount, func(context.Context) error { return nil }, q, qr, nil)
+	require.Error(t, err, "unexpected returned type")
+	_, err = entcore.Intercept[[]*node](ctx, struct{}{}, qr, nil)
+	require.EqualError(t, err, "unexpected query type struct {}")
+
+	ids, err := entcore.IDs[int](ctx, qc, func(ctx context.Context, v any) error {
+		require.Equal(t, ent.OpQueryIDs, ent.QueryFromContext(ctx).Op)
+		*v.(*[]int) = []int{1, 2}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, ids)
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+	scan := func(_ context.Context, q *query, v any) error {
+		for _, n := range q.nodes {
+			*v.(*[]int) = append(*v.(*[]int), n.ID)
+		}
+		return nil
+	}
+	var ids []int
+	inter := ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			return append(v.([]int), 3), nil
+		})
+	})
+	err := entcore.Scan(ctx, &query{nodes: []*node{{ID: 1}, {ID: 2}}}, scan, []ent.Interceptor{inter}, &ids)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestWithHooks(t *testing.T) {
+	ctx := context.Background()
+	m := &mutation{name: "a"}
+	exec := func(context.Context) (*node, error) {
+		return &node{ID: len(m.name)}, nil
+	}
+	hook := func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, v ent.Mutation) (ent.Value, error) {
+			return next.Mutate(ctx, &mutation{name: v.(*mutation).name + "b"})
+		})
+	}
+	n, err := entcore.WithHooks(ctx, exec, m, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, n.ID)
+	n, err = entcore.WithHooks(ctx, exec, m, []ent.Hook{hook, hook})
+	require.NoError(t, err)
+	require.Equal(t, 3, n.ID)
+	require.Equal(t, "abb", m.name, "mutation is set to the one passed by the hooks")
+
+	_, err = entcore.WithHooks(ctx, exec, m, []ent.Hook{nil})
+	require.EqualError(t, err, "ent: uninitialized hook (forgotten import ent/runtime?)")
+	_, err = entcore.WithHooks(ctx, exec, m, []ent.Hook{func(ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return 1, nil
+		})
+	}})
+	require.EqualError(t, err, "unexpected node type int returned from *entcore_test.mutation")
+}
+
+func TestMutationIDs(t *testing.T) {
+	ctx := context.Background()
+	id := 1
+	query := func(context.Context) ([]int, error) { return []int{2, 3}, nil }
+	ids, err := entcore.MutationIDs(ctx, ent.OpUpdateOne, &id, query)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, ids)
+	ids, err = entcore.MutationIDs(ctx, ent.OpDeleteOne, nil, query)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, ids)
+	ids, err = entcore.MutationIDs(ctx, ent.OpUpdate, &id, query)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, ids)
+	_, err = entcore.MutationIDs(ctx, ent.OpCreate, &id, query)
+	require.EqualError(t, err, "IDs is not allowed on OpCreate operations")
+}
@@ -317,6 +317,7 @@ func (_q *CityQuery) GroupBy(field string, fields ...string) *CityGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = city.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -556,11 +557,11 @@ func (_g *CityGroupBy) sqlScan(ctx context.Context, root *CityQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -568,6 +569,42 @@ func (_g *CityGroupBy) sqlScan(ctx context.Context, root *CityQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// CityGroupRow is a typed row that is returned by the CityGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type CityGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *CityGroupBy) Rows(ctx context.Context) ([]*CityGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*CityGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *CityGroupBy) RowsX(ctx context.Context) []*CityGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // CitySelect is the builder for selecting fields of City entities.
 type CitySelect struct {
 	*CityQuery
@@ -603,6 +640,9 @@ func (_s *CitySelect) sqlScan(ctx context.Context, root *CityQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
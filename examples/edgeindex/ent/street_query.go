@@ -317,6 +317,7 @@ func (_q *StreetQuery) GroupBy(field string, fields ...string) *StreetGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = street.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -563,11 +564,11 @@ func (_g *StreetGroupBy) sqlScan(ctx context.Context, root *StreetQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -575,6 +576,42 @@ func (_g *StreetGroupBy) sqlScan(ctx context.Context, root *StreetQuery, v any)
 	return sql.ScanSlice(rows, v)
 }
 
+// StreetGroupRow is a typed row that is returned by the StreetGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type StreetGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *StreetGroupBy) Rows(ctx context.Context) ([]*StreetGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*StreetGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *StreetGroupBy) RowsX(ctx context.Context) []*StreetGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // StreetSelect is the builder for selecting fields of Street entities.
 type StreetSelect struct {
 	*StreetQuery
@@ -610,6 +647,9 @@ func (_s *StreetSelect) sqlScan(ctx context.Context, root *StreetQuery, v any) e
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -351,6 +351,7 @@ func (_q *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = file.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -628,11 +629,11 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -640,6 +641,48 @@ func (_g *FileGroupBy) sqlScan(ctx context.Context, root *FileQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// FileGroupRow is a typed row that is returned by the FileGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type FileGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	Name         string  `json:"name,omitempty" sql:"name"`
+	Deleted      bool    `json:"deleted,omitempty" sql:"deleted"`
+	ParentID     int     `json:"parent_id,omitempty" sql:"parent_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumParentID  int     `json:"sum_parent_id,omitempty" sql:"sum_parent_id"`
+	MinParentID  int     `json:"min_parent_id,omitempty" sql:"min_parent_id"`
+	MaxParentID  int     `json:"max_parent_id,omitempty" sql:"max_parent_id"`
+	MeanParentID float64 `json:"mean_parent_id,omitempty" sql:"mean_parent_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *FileGroupBy) Rows(ctx context.Context) ([]*FileGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*FileGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *FileGroupBy) RowsX(ctx context.Context) []*FileGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // FileSelect is the builder for selecting fields of File entities.
 type FileSelect struct {
 	*FileQuery
@@ -675,6 +718,9 @@ func (_s *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -316,6 +317,7 @@ func (_q *PaymentQuery) GroupBy(field string, fields ...string) *PaymentGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = payment.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -555,11 +557,11 @@ func (_g *PaymentGroupBy) sqlScan(ctx context.Context, root *PaymentQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -567,6 +569,57 @@ func (_g *PaymentGroupBy) sqlScan(ctx context.Context, root *PaymentQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// PaymentGroupRow is a typed row that is returned by the PaymentGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type PaymentGroupRow struct {
+	ID          int              `json:"id,omitempty" sql:"id"`
+	CardID      int              `json:"card_id,omitempty" sql:"card_id"`
+	Amount      float64          `json:"amount,omitempty" sql:"amount"`
+	Currency    payment.Currency `json:"currency,omitempty" sql:"currency"`
+	Time        time.Time        `json:"time,omitempty" sql:"time"`
+	Description string           `json:"description,omitempty" sql:"description"`
+	Status      payment.Status   `json:"status,omitempty" sql:"status"`
+	Bucket      time.Time        `json:"bucket,omitempty" sql:"bucket"`
+	Count       int              `json:"count,omitempty" sql:"count"`
+	SumCardID   int              `json:"sum_card_id,omitempty" sql:"sum_card_id"`
+	MinCardID   int              `json:"min_card_id,omitempty" sql:"min_card_id"`
+	MaxCardID   int              `json:"max_card_id,omitempty" sql:"max_card_id"`
+	MeanCardID  float64          `json:"mean_card_id,omitempty" sql:"mean_card_id"`
+	SumAmount   float64          `json:"sum_amount,omitempty" sql:"sum_amount"`
+	MinAmount   float64          `json:"min_amount,omitempty" sql:"min_amount"`
+	MaxAmount   float64          `json:"max_amount,omitempty" sql:"max_amount"`
+	MeanAmount  float64          `json:"mean_amount,omitempty" sql:"mean_amount"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *PaymentGroupBy) Rows(ctx context.Context) ([]*PaymentGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*PaymentGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *PaymentGroupBy) RowsX(ctx context.Context) []*PaymentGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // PaymentSelect is the builder for selecting fields of Payment entities.
 type PaymentSelect struct {
 	*PaymentQuery
@@ -602,6 +655,9 @@ func (_s *PaymentSelect) sqlScan(ctx context.Context, root *PaymentQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -352,6 +352,7 @@ func (_q *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = pet.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -630,11 +631,11 @@ func (_g *PetGroupBy) sqlScan(ctx context.Context, root *PetQuery, v any) error
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -642,6 +643,58 @@ func (_g *PetGroupBy) sqlScan(ctx context.Context, root *PetQuery, v any) error
 	return sql.ScanSlice(rows, v)
 }
 
+// PetGroupRow is a typed row that is returned by the PetGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type PetGroupRow struct {
+	ID           uuid.UUID `json:"id,omitempty" sql:"id"`
+	Name         string    `json:"name,omitempty" sql:"name"`
+	Age          float64   `json:"age,omitempty" sql:"age"`
+	Weight       float64   `json:"weight,omitempty" sql:"weight"`
+	BestFriendID uuid.UUID `json:"best_friend_id,omitempty" sql:"best_friend_id"`
+	OwnerID      int       `json:"owner_id,omitempty" sql:"owner_id"`
+	Count        int       `json:"count,omitempty" sql:"count"`
+	SumAge       float64   `json:"sum_age,omitempty" sql:"sum_age"`
+	MinAge       float64   `json:"min_age,omitempty" sql:"min_age"`
+	MaxAge       float64   `json:"max_age,omitempty" sql:"max_age"`
+	MeanAge      float64   `json:"mean_age,omitempty" sql:"mean_age"`
+	SumWeight    float64   `json:"sum_weight,omitempty" sql:"sum_weight"`
+	MinWeight    float64   `json:"min_weight,omitempty" sql:"min_weight"`
+	MaxWeight    float64   `json:"max_weight,omitempty" sql:"max_weight"`
+	MeanWeight   float64   `json:"mean_weight,omitempty" sql:"mean_weight"`
+	SumOwnerID   int       `json:"sum_owner_id,omitempty" sql:"sum_owner_id"`
+	MinOwnerID   int       `json:"min_owner_id,omitempty" sql:"min_owner_id"`
+	MaxOwnerID   int       `json:"max_owner_id,omitempty" sql:"max_owner_id"`
+	MeanOwnerID  float64   `json:"mean_owner_id,omitempty" sql:"mean_owner_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *PetGroupBy) Rows(ctx context.Context) ([]*PetGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*PetGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *PetGroupBy) RowsX(ctx context.Context) []*PetGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // PetSelect is the builder for selecting fields of Pet entities.
 type PetSelect struct {
 	*PetQuery
@@ -677,6 +730,9 @@ func (_s *PetSelect) sqlScan(ctx context.Context, root *PetQuery, v any) error {
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -317,6 +318,7 @@ func (_q *SessionQuery) GroupBy(field string, fields ...string) *SessionGroupBy
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = session.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -556,11 +558,11 @@ func (_g *SessionGroupBy) sqlScan(ctx context.Context, root *SessionQuery, v any
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -568,6 +570,49 @@ func (_g *SessionGroupBy) sqlScan(ctx context.Context, root *SessionQuery, v any
 	return sql.ScanSlice(rows, v)
 }
 
+// SessionGroupRow is a typed row that is returned by the SessionGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type SessionGroupRow struct {
+	ID        uuid.UUID              `json:"id,omitempty" sql:"id"`
+	Active    bool                   `json:"active,omitempty" sql:"active"`
+	IssuedAt  time.Time              `json:"issued_at,omitempty" sql:"issued_at"`
+	ExpiresAt time.Time              `json:"expires_at,omitempty" sql:"expires_at"`
+	Token     string                 `json:"token,omitempty" sql:"token"`
+	Method    map[string]interface{} `json:"method,omitempty" sql:"method"`
+	DeviceID  uuid.UUID              `json:"device_id,omitempty" sql:"device_id"`
+	Bucket    time.Time              `json:"bucket,omitempty" sql:"bucket"`
+	Count     int                    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *SessionGroupBy) Rows(ctx context.Context) ([]*SessionGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*SessionGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *SessionGroupBy) RowsX(ctx context.Context) []*SessionGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // SessionSelect is the builder for selecting fields of Session entities.
 type SessionSelect struct {
 	*SessionQuery
@@ -603,6 +648,9 @@ func (_s *SessionSelect) sqlScan(ctx context.Context, root *SessionQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
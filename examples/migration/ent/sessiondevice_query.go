@@ -11,6 +11,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -318,6 +319,7 @@ func (_q *SessionDeviceQuery) GroupBy(field string, fields ...string) *SessionDe
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = sessiondevice.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -556,11 +558,11 @@ func (_g *SessionDeviceGroupBy) sqlScan(ctx context.Context, root *SessionDevice
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -568,6 +570,48 @@ func (_g *SessionDeviceGroupBy) sqlScan(ctx context.Context, root *SessionDevice
 	return sql.ScanSlice(rows, v)
 }
 
+// SessionDeviceGroupRow is a typed row that is returned by the SessionDeviceGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+// Bucket holds the start time of the bucket of the Bucket aggregation.
+type SessionDeviceGroupRow struct {
+	ID        uuid.UUID `json:"id,omitempty" sql:"id"`
+	IPAddress string    `json:"ip_address,omitempty" sql:"ip_address"`
+	UserAgent string    `json:"user_agent,omitempty" sql:"user_agent"`
+	Location  string    `json:"location,omitempty" sql:"location"`
+	CreatedAt time.Time `json:"created_at,omitempty" sql:"created_at"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" sql:"updated_at"`
+	Bucket    time.Time `json:"bucket,omitempty" sql:"bucket"`
+	Count     int       `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *SessionDeviceGroupBy) Rows(ctx context.Context) ([]*SessionDeviceGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*SessionDeviceGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *SessionDeviceGroupBy) RowsX(ctx context.Context) []*SessionDeviceGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // SessionDeviceSelect is the builder for selecting fields of SessionDevice entities.
 type SessionDeviceSelect struct {
 	*SessionDeviceQuery
@@ -603,6 +647,9 @@ func (_s *SessionDeviceSelect) sqlScan(ctx context.Context, root *SessionDeviceQ
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
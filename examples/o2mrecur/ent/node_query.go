@@ -351,6 +351,7 @@ func (_q *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = node.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -628,11 +629,11 @@ func (_g *NodeGroupBy) sqlScan(ctx context.Context, root *NodeQuery, v any) erro
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -640,6 +641,51 @@ func (_g *NodeGroupBy) sqlScan(ctx context.Context, root *NodeQuery, v any) erro
 	return sql.ScanSlice(rows, v)
 }
 
+// NodeGroupRow is a typed row that is returned by the NodeGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type NodeGroupRow struct {
+	ID           int     `json:"id,omitempty" sql:"id"`
+	Value        int     `json:"value,omitempty" sql:"value"`
+	ParentID     int     `json:"parent_id,omitempty" sql:"parent_id"`
+	Count        int     `json:"count,omitempty" sql:"count"`
+	SumValue     int     `json:"sum_value,omitempty" sql:"sum_value"`
+	MinValue     int     `json:"min_value,omitempty" sql:"min_value"`
+	MaxValue     int     `json:"max_value,omitempty" sql:"max_value"`
+	MeanValue    float64 `json:"mean_value,omitempty" sql:"mean_value"`
+	SumParentID  int     `json:"sum_parent_id,omitempty" sql:"sum_parent_id"`
+	MinParentID  int     `json:"min_parent_id,omitempty" sql:"min_parent_id"`
+	MaxParentID  int     `json:"max_parent_id,omitempty" sql:"max_parent_id"`
+	MeanParentID float64 `json:"mean_parent_id,omitempty" sql:"mean_parent_id"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *NodeGroupBy) Rows(ctx context.Context) ([]*NodeGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*NodeGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *NodeGroupBy) RowsX(ctx context.Context) []*NodeGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // NodeSelect is the builder for selecting fields of Node entities.
 type NodeSelect struct {
 	*NodeQuery
@@ -675,6 +721,9 @@ func (_s *NodeSelect) sqlScan(ctx context.Context, root *NodeQuery, v any) error
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -281,6 +281,7 @@ func (_q *TenantQuery) GroupBy(field string, fields ...string) *TenantGroupBy {
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = tenant.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -483,11 +484,11 @@ func (_g *TenantGroupBy) sqlScan(ctx context.Context, root *TenantQuery, v any)
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -495,6 +496,42 @@ func (_g *TenantGroupBy) sqlScan(ctx context.Context, root *TenantQuery, v any)
 	return sql.ScanSlice(rows, v)
 }
 
+// TenantGroupRow is a typed row that is returned by the TenantGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type TenantGroupRow struct {
+	ID    int    `json:"id,omitempty" sql:"id"`
+	Name  string `json:"name,omitempty" sql:"name"`
+	Count int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *TenantGroupBy) Rows(ctx context.Context) ([]*TenantGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*TenantGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *TenantGroupBy) RowsX(ctx context.Context) []*TenantGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // TenantSelect is the builder for selecting fields of Tenant entities.
 type TenantSelect struct {
 	*TenantQuery
@@ -530,6 +567,9 @@ func (_s *TenantSelect) sqlScan(ctx context.Context, root *TenantQuery, v any) e
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -276,6 +276,7 @@ func (_q *UserAuditLogQuery) GroupBy(field string, fields ...string) *UserAuditL
 	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = userauditlog.Label
 	grbuild.scan = grbuild.Scan
+	_q.ctx.Fields, grbuild.fns = groupByEdgeFields(_q.ctx.Fields)
 	return grbuild
 }
 
@@ -472,11 +473,11 @@ func (_g *UserAuditLogGroupBy) sqlScan(ctx context.Context, root *UserAuditLogQu
 		selector.Select(columns...)
 	}
 	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	rows := &sql.Rows{}
+	query, args := selector.Query()
 	if err := selector.Err(); err != nil {
 		return err
 	}
-	rows := &sql.Rows{}
-	query, args := selector.Query()
 	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -484,6 +485,45 @@ func (_g *UserAuditLogGroupBy) sqlScan(ctx context.Context, root *UserAuditLogQu
 	return sql.ScanSlice(rows, v)
 }
 
+// UserAuditLogGroupRow is a typed row that is returned by the UserAuditLogGroupBy.Rows method.
+// Fields that were not grouped by or aggregated hold their zero values.
+type UserAuditLogGroupRow struct {
+	ID            int    `json:"id,omitempty" sql:"id"`
+	OperationType string `json:"operation_type,omitempty" sql:"operation_type"`
+	OperationTime string `json:"operation_time,omitempty" sql:"operation_time"`
+	OldValue      string `json:"old_value,omitempty" sql:"old_value"`
+	NewValue      string `json:"new_value,omitempty" sql:"new_value"`
+	Count         int    `json:"count,omitempty" sql:"count"`
+}
+
+// Rows applies the group-by query and returns its result as typed rows. The results
+// of the Sum, Min, Max, Mean and Count aggregation functions are stored in the fields
+// named after the function and the aggregated field, for example, SumAge. Aggregations
+// that were renamed using the As function, custom aggregation functions and edge fields
+// are not stored in the rows, use Scan instead.
+func (_g *UserAuditLogGroupBy) Rows(ctx context.Context) ([]*UserAuditLogGroupRow, error) {
+	fns := _g.fns
+	defer func() { _g.fns = fns }()
+	_g.fns = make([]AggregateFunc, len(fns))
+	for i, fn := range fns {
+		_g.fns[i] = aggregateAs(fn)
+	}
+	var v []*UserAuditLogGroupRow
+	if err := _g.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// RowsX is like Rows, but panics if an error occurs.
+func (_g *UserAuditLogGroupBy) RowsX(ctx context.Context) []*UserAuditLogGroupRow {
+	v, err := _g.Rows(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // UserAuditLogSelect is the builder for selecting fields of UserAuditLog entities.
 type UserAuditLogSelect struct {
 	*UserAuditLogQuery
@@ -519,6 +559,9 @@ func (_s *UserAuditLogSelect) sqlScan(ctx context.Context, root *UserAuditLogQue
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
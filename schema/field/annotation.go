@@ -79,3 +79,25 @@ var _ interface {
 	schema.Annotation
 	schema.Merger
 } = (*Annotation)(nil)
+
+// RenameAnnotation is a field annotation that records the previous name of
+// a renamed field. It does not affect the generated code, but it is used by
+// the `entfix rename` command to rewrite the usage of the APIs that were
+// generated for the previous name of the field.
+type RenameAnnotation struct {
+	// From is the previous name of the field.
+	From string
+}
+
+// RenamedFrom returns a field annotation that records the previous name of the field.
+//
+//	field.String("full_name").
+//		Annotations(field.RenamedFrom("name"))
+func RenamedFrom(name string) *RenameAnnotation {
+	return &RenameAnnotation{From: name}
+}
+
+// Name describes the annotation name.
+func (RenameAnnotation) Name() string {
+	return "FieldRename"
+}
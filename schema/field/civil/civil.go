@@ -0,0 +1,392 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package civil provides the Go types of the field.Date, field.TimeOfDay
+// and field.Duration fields. Unlike time.Time, the Date and Time types do
+// not describe a point in time, and therefore are not affected by time zones.
+package civil
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Date represents a calendar date (year, month, day), without time and time zone.
+type Date struct {
+	Year  int        // Year (e.g., 2024).
+	Month time.Month // Month of the year (January = 1, ...).
+	Day   int        // Day of the month, starting at 1.
+}
+
+// DateOf returns the Date in which the given time occurs in its location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the current date in the given location.
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// ParseDate parses a string in the "2006-01-02" format and returns the Date it represents.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in the "2006-01-02" format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid reports whether the date is a valid calendar date.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// IsZero reports whether the date is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the time at midnight of the date in the given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// At returns the time at the given time of the date in the given location.
+func (d Date) At(t Time, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// AddDays returns the date n days after d. n can be negative.
+func (d Date) AddDays(n int) Date {
+	return d.AddDate(0, 0, n)
+}
+
+// AddDate returns the date after adding the given number of years, months
+// and days to d. Like time.Time.AddDate, the result is normalized, for example,
+// adding one month to October 31 yields December 1.
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
+// DaysSince returns the number of days between s and d (d - s).
+func (d Date) DaysSince(s Date) int {
+	return int(d.In(time.UTC).Sub(s.In(time.UTC)) / (24 * time.Hour))
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// Compare compares d and d2. It returns -1 if d is before d2,
+// +1 if d is after d2, and 0 if they are the same date.
+func (d Date) Compare(d2 Date) int {
+	switch {
+	case d.Year != d2.Year:
+		return cmp.Compare(d.Year, d2.Year)
+	case d.Month != d2.Month:
+		return cmp.Compare(d.Month, d2.Month)
+	default:
+		return cmp.Compare(d.Day, d2.Day)
+	}
+}
+
+// Before reports whether d occurs before d2.
+func (d Date) Before(d2 Date) bool {
+	return d.Compare(d2) < 0
+}
+
+// After reports whether d occurs after d2.
+func (d Date) After(d2 Date) bool {
+	return d.Compare(d2) > 0
+}
+
+// Value implements the driver.Valuer interface.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. DATE values are scanned
+// as they are stored in the database, without time zone conversion.
+func (d *Date) Scan(v any) (err error) {
+	switch v := v.(type) {
+	case nil:
+		*d = Date{}
+	case time.Time:
+		*d = DateOf(v)
+	case []byte:
+		*d, err = parseDateValue(string(v))
+	case string:
+		*d, err = parseDateValue(v)
+	default:
+		err = fmt.Errorf("civil: unexpected type for date: %T", v)
+	}
+	return err
+}
+
+// parseDateValue parses a DATE value that might be followed by a time part.
+func parseDateValue(s string) (Date, error) {
+	if len(s) > len(time.DateOnly) {
+		s = s[:len(time.DateOnly)]
+	}
+	d, err := ParseDate(s)
+	if err != nil {
+		return Date{}, fmt.Errorf("civil: invalid date value %q", s)
+	}
+	return d, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Date) UnmarshalText(b []byte) (err error) {
+	*d, err = ParseDate(string(b))
+	return err
+}
+
+// A Time represents a time of day, without date and time zone.
+type Time struct {
+	Hour       int // The hour of the day in 24-hour format, in the range [0, 23].
+	Minute     int // The minute of the hour, in the range [0, 59].
+	Second     int // The second of the minute, in the range [0, 59].
+	Nanosecond int // The nanosecond of the second, in the range [0, 999999999].
+}
+
+// TimeOf returns the Time representing the time of day in which the given time occurs in its location.
+func TimeOf(t time.Time) Time {
+	return Time{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseTime parses a string in the "15:04:05" format, with optional
+// fractional seconds, and returns the Time it represents.
+func ParseTime(s string) (Time, error) {
+	t, err := time.Parse("15:04:05.999999999", s)
+	if err != nil {
+		return Time{}, err
+	}
+	return TimeOf(t), nil
+}
+
+// String returns the time in the "15:04:05" format. Fractional
+// seconds are appended only if the nanosecond part is not zero.
+func (t Time) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond == 0 {
+		return s
+	}
+	return s + strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+}
+
+// IsValid reports whether the time is a valid time of day.
+func (t Time) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 && t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 && t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// IsZero reports whether the time is the zero value (midnight).
+func (t Time) IsZero() bool {
+	return t == Time{}
+}
+
+// Add returns the time of day after adding the given duration to t.
+// The result wraps around midnight, for example, 23:00 + 2h is 01:00.
+func (t Time) Add(d time.Duration) Time {
+	return TimeOf(Date{Year: 2000, Month: 1, Day: 1}.At(t, time.UTC).Add(d))
+}
+
+// Sub returns the duration t-t2 within the same day.
+func (t Time) Sub(t2 Time) time.Duration {
+	return t.sinceMidnight() - t2.sinceMidnight()
+}
+
+// Compare compares t and t2. It returns -1 if t is before t2,
+// +1 if t is after t2, and 0 if they are the same time of day.
+func (t Time) Compare(t2 Time) int {
+	return cmp.Compare(t.sinceMidnight(), t2.sinceMidnight())
+}
+
+// Before reports whether t occurs before t2.
+func (t Time) Before(t2 Time) bool {
+	return t.Compare(t2) < 0
+}
+
+// After reports whether t occurs after t2.
+func (t Time) After(t2 Time) bool {
+	return t.Compare(t2) > 0
+}
+
+func (t Time) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// Value implements the driver.Valuer interface.
+func (t Time) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *Time) Scan(v any) (err error) {
+	switch v := v.(type) {
+	case nil:
+		*t = Time{}
+	case time.Time:
+		*t = TimeOf(v)
+	case []byte:
+		*t, err = parseTimeValue(string(v))
+	case string:
+		*t, err = parseTimeValue(v)
+	default:
+		err = fmt.Errorf("civil: unexpected type for time: %T", v)
+	}
+	return err
+}
+
+// parseTimeValue parses a TIME value that might be prefixed with a date part.
+func parseTimeValue(s string) (Time, error) {
+	if i := strings.IndexAny(s, " T"); i != -1 {
+		s = s[i+1:]
+	}
+	t, err := ParseTime(s)
+	if err != nil {
+		return Time{}, fmt.Errorf("civil: invalid time value %q", s)
+	}
+	return t, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *Time) UnmarshalText(b []byte) (err error) {
+	*t, err = ParseTime(string(b))
+	return err
+}
+
+// A Duration is a time.Duration that is stored as an INTERVAL in PostgreSQL,
+// and as an integer number of nanoseconds in MySQL and SQLite.
+type Duration time.Duration
+
+// String returns the duration formatted by time.Duration.
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// Value implements the driver.Valuer interface.
+func (d Duration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *Duration) Scan(v any) (err error) {
+	switch v := v.(type) {
+	case nil:
+		*d = 0
+	case int64:
+		*d = Duration(v)
+	case float64:
+		*d = Duration(v)
+	case []byte:
+		*d, err = parseDurationValue(string(v))
+	case string:
+		*d, err = parseDurationValue(v)
+	default:
+		err = fmt.Errorf("civil: unexpected type for duration: %T", v)
+	}
+	return err
+}
+
+// parseDurationValue parses an integer number of nanoseconds, or
+// a PostgreSQL INTERVAL in its default output format. For example:
+//
+//	3600000000000
+//	01:00:00
+//	-2 days +03:04:05.678
+func parseDurationValue(s string) (Duration, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return Duration(n), nil
+	}
+	var (
+		d      time.Duration
+		fields = strings.Fields(s)
+	)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("civil: invalid duration value %q", s)
+	}
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			c, err := parseClock(f)
+			if err != nil {
+				return 0, fmt.Errorf("civil: invalid duration value %q", s)
+			}
+			d += c
+			continue
+		}
+		n, err := strconv.ParseInt(f, 10, 64)
+		if err != nil || i+1 == len(fields) {
+			return 0, fmt.Errorf("civil: invalid duration value %q", s)
+		}
+		switch i++; strings.TrimSuffix(fields[i], "s") {
+		case "day":
+			d += time.Duration(n) * 24 * time.Hour
+		case "hour":
+			d += time.Duration(n) * time.Hour
+		case "min", "minute":
+			d += time.Duration(n) * time.Minute
+		case "sec", "second":
+			d += time.Duration(n) * time.Second
+		case "year", "mon", "month":
+			return 0, fmt.Errorf("civil: duration value %q with months cannot be represented as nanoseconds", s)
+		default:
+			return 0, fmt.Errorf("civil: invalid duration value %q", s)
+		}
+	}
+	return Duration(d), nil
+}
+
+// parseClock parses the time part of an INTERVAL ("[+-]hh:mm:ss[.ffffff]").
+func parseClock(s string) (time.Duration, error) {
+	sign := time.Duration(1)
+	switch s[0] {
+	case '-':
+		sign, s = -1, s[1:]
+	case '+':
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid clock %q", s)
+	}
+	h, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, err
+	}
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec*float64(time.Second))
+	return sign * d.Round(time.Microsecond), nil
+}
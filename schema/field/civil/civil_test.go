@@ -0,0 +1,110 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package civil_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"entgo.io/ent/schema/field/civil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	d, err := civil.ParseDate("2024-02-28")
+	require.NoError(t, err)
+	require.Equal(t, civil.Date{Year: 2024, Month: time.February, Day: 28}, d)
+	require.Equal(t, "2024-02-28", d.String())
+	require.True(t, d.IsValid())
+	require.False(t, civil.Date{Year: 2023, Month: time.February, Day: 29}.IsValid())
+
+	require.Equal(t, civil.Date{Year: 2024, Month: time.February, Day: 29}, d.AddDays(1))
+	require.Equal(t, civil.Date{Year: 2024, Month: time.March, Day: 1}, d.AddDays(2))
+	require.Equal(t, civil.Date{Year: 2025, Month: time.February, Day: 28}, d.AddDate(1, 0, 0))
+	require.Equal(t, 2, d.AddDays(2).DaysSince(d))
+	require.Equal(t, -366, d.DaysSince(d.AddDate(1, 0, 0)))
+	require.Equal(t, time.Wednesday, d.Weekday())
+	require.True(t, d.Before(d.AddDays(1)))
+	require.True(t, d.After(d.AddDate(0, -1, 0)))
+	require.Zero(t, d.Compare(d))
+
+	// Dates are scanned as stored, regardless of the time zone.
+	loc := time.FixedZone("", -10*3600)
+	require.NoError(t, d.Scan(time.Date(2000, 1, 2, 0, 0, 0, 0, loc)))
+	require.Equal(t, "2000-01-02", d.String())
+	require.NoError(t, d.Scan([]byte("2000-01-03")))
+	require.Equal(t, "2000-01-03", d.String())
+	require.NoError(t, d.Scan("2000-01-04 00:00:00"))
+	require.Equal(t, "2000-01-04", d.String())
+	require.NoError(t, d.Scan(nil))
+	require.True(t, d.IsZero())
+	require.EqualError(t, d.Scan("2000-13-01"), `civil: invalid date value "2000-13-01"`)
+	require.Error(t, d.Scan(1))
+
+	v, err := civil.Date{Year: 1990, Month: time.May, Day: 1}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "1990-05-01", v)
+	b, err := json.Marshal(civil.Date{Year: 1990, Month: time.May, Day: 1})
+	require.NoError(t, err)
+	require.Equal(t, `"1990-05-01"`, string(b))
+	require.NoError(t, json.Unmarshal(b, &d))
+	require.Equal(t, civil.Date{Year: 1990, Month: time.May, Day: 1}, d)
+}
+
+func TestTime(t *testing.T) {
+	tm, err := civil.ParseTime("09:30:00")
+	require.NoError(t, err)
+	require.Equal(t, civil.Time{Hour: 9, Minute: 30}, tm)
+	require.Equal(t, "09:30:00", tm.String())
+	require.Equal(t, "09:30:00.5", civil.Time{Hour: 9, Minute: 30, Nanosecond: 5e8}.String())
+	require.True(t, tm.IsValid())
+	require.False(t, civil.Time{Hour: 24}.IsValid())
+
+	require.Equal(t, civil.Time{Hour: 1, Minute: 30}, tm.Add(16*time.Hour))
+	require.Equal(t, 30*time.Minute, tm.Sub(civil.Time{Hour: 9}))
+	require.True(t, tm.Before(civil.Time{Hour: 10}))
+	require.True(t, tm.After(civil.Time{Hour: 9}))
+
+	require.NoError(t, tm.Scan([]byte("13:14:15.123456")))
+	require.Equal(t, civil.Time{Hour: 13, Minute: 14, Second: 15, Nanosecond: 123456000}, tm)
+	require.NoError(t, tm.Scan("0000-01-01 08:00:00"))
+	require.Equal(t, civil.Time{Hour: 8}, tm)
+	require.NoError(t, tm.Scan(time.Date(2000, 1, 1, 7, 6, 5, 0, time.UTC)))
+	require.Equal(t, civil.Time{Hour: 7, Minute: 6, Second: 5}, tm)
+	require.EqualError(t, tm.Scan("25:00:00"), `civil: invalid time value "25:00:00"`)
+
+	v, err := civil.Time{Hour: 23, Minute: 59, Second: 59}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "23:59:59", v)
+}
+
+func TestDuration(t *testing.T) {
+	d := civil.Duration(90 * time.Minute)
+	require.Equal(t, "1h30m0s", d.String())
+	v, err := d.Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(90*time.Minute), v)
+
+	for _, tt := range []struct {
+		in   any
+		want time.Duration
+	}{
+		{in: int64(time.Second), want: time.Second},
+		{in: []byte("1000"), want: 1000},
+		{in: "01:02:03", want: time.Hour + 2*time.Minute + 3*time.Second},
+		{in: "-00:00:01.5", want: -1500 * time.Millisecond},
+		{in: "36:00:00", want: 36 * time.Hour},
+		{in: "1 day", want: 24 * time.Hour},
+		{in: "-2 days +03:00:00", want: -45 * time.Hour},
+		{in: nil, want: 0},
+	} {
+		require.NoError(t, d.Scan(tt.in))
+		require.Equal(t, tt.want, time.Duration(d), tt.in)
+	}
+	require.Error(t, d.Scan("1 mon 2 days"))
+	require.Error(t, d.Scan("1 hour ago"))
+}
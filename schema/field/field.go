@@ -11,6 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
+	"net/netip"
 	"reflect"
 	"regexp"
 	"strings"
@@ -18,6 +20,7 @@ import (
 	"unicode/utf8"
 
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field/civil"
 )
 
 // String returns a new Field with type string.
@@ -63,6 +66,62 @@ func Time(name string) *timeBuilder {
 	}}
 }
 
+// Date returns a new Field with type date. Unlike Time, date fields hold a
+// calendar date with no time-of-day or time zone. For example:
+//
+//	field.Date("birthday").
+//		Optional()
+func Date(name string) *civilBuilder[civil.Date] {
+	return cb[civil.Date](name, TypeDate)
+}
+
+// TimeOfDay returns a new Field with type time (of day). In MySQL, PostgreSQL
+// and SQLite, it is the "time" type.
+//
+//	field.TimeOfDay("opens_at").
+//		Default(func() civil.Time { return civil.Time{Hour: 9} })
+func TimeOfDay(name string) *civilBuilder[civil.Time] {
+	return cb[civil.Time](name, TypeTimeOfDay)
+}
+
+// Duration returns a new Field with type duration. In PostgreSQL, it is the
+// "interval" type, and in MySQL and SQLite it is stored as nanoseconds in a
+// "bigint" column.
+//
+//	field.Duration("timeout").
+//		Default(func() civil.Duration { return civil.Duration(time.Minute) })
+func Duration(name string) *civilBuilder[civil.Duration] {
+	return cb[civil.Duration](name, TypeDuration)
+}
+
+// IP returns a new Field with type IP address, represented by netip.Addr.
+// In PostgreSQL, it is the "inet" type, and in MySQL and SQLite it is stored
+// as text.
+//
+//	field.IP("addr").
+//		Unique()
+func IP(name string) *netBuilder[netip.Addr] {
+	return nb[netip.Addr](name, TypeIP, addrValueScanner{})
+}
+
+// Prefix returns a new Field with type IP network prefix, represented by netip.Prefix.
+// In PostgreSQL, it is the "cidr" type, and in MySQL and SQLite it is stored as text.
+//
+//	field.Prefix("subnet")
+func Prefix(name string) *netBuilder[netip.Prefix] {
+	return nb[netip.Prefix](name, TypePrefix, prefixValueScanner{})
+}
+
+// MAC returns a new Field with type MAC address, represented by net.HardwareAddr.
+// In PostgreSQL, it is the "macaddr" type, and in MySQL and SQLite it is stored
+// as text.
+//
+//	field.MAC("hwaddr").
+//		Optional()
+func MAC(name string) *netBuilder[net.HardwareAddr] {
+	return nb[net.HardwareAddr](name, TypeMAC, macValueScanner{})
+}
+
 // JSON returns a new Field with type json that is serialized to the given object.
 // For example:
 //
@@ -518,6 +577,263 @@ func (b *timeBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
 
+type (
+	civilType interface {
+		civil.Date | civil.Time | civil.Duration
+	}
+	// civilBuilder is the builder for date, time-of-day and duration fields.
+	civilBuilder[T civilType] struct {
+		desc *Descriptor
+	}
+)
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated struct.
+func (b *civilBuilder[T]) Nillable() *civilBuilder[T] {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *civilBuilder[T]) Optional() *civilBuilder[T] {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable fields are fields that can be set only in the creation of the entity.
+// i.e., no setters will be generated for the entity updaters (one and many).
+func (b *civilBuilder[T]) Immutable() *civilBuilder[T] {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *civilBuilder[T]) Comment(c string) *civilBuilder[T] {
+	b.desc.Comment = c
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *civilBuilder[T]) StructTag(s string) *civilBuilder[T] {
+	b.desc.Tag = s
+	return b
+}
+
+// Default sets the function that is applied to set default value
+// of the field on creation. For example:
+//
+//	field.Date("joined_on").
+//		Default(func() civil.Date { return civil.Today(time.UTC) })
+func (b *civilBuilder[T]) Default(fn func() T) *civilBuilder[T] {
+	b.desc.Default = fn
+	return b
+}
+
+// UpdateDefault sets the function that is applied to set default value
+// of the field on update.
+func (b *civilBuilder[T]) UpdateDefault(fn func() T) *civilBuilder[T] {
+	b.desc.UpdateDefault = fn
+	return b
+}
+
+// Validate adds a validator for this field. Operation fails if the validation fails.
+//
+//	field.Duration("timeout").
+//		Validate(func(d civil.Duration) error {
+//			if d <= 0 {
+//				return errors.New("timeout must be positive")
+//			}
+//			return nil
+//		})
+func (b *civilBuilder[T]) Validate(fn func(T) error) *civilBuilder[T] {
+	b.desc.Validators = append(b.desc.Validators, fn)
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *civilBuilder[T]) StorageKey(key string) *civilBuilder[T] {
+	b.desc.StorageKey = key
+	return b
+}
+
+// SchemaType overrides the default database type with a custom
+// schema type (per dialect). For example:
+//
+//	field.Duration("timeout").
+//		SchemaType(map[string]string{
+//			dialect.MySQL: "bigint unsigned",
+//		})
+func (b *civilBuilder[T]) SchemaType(types map[string]string) *civilBuilder[T] {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *civilBuilder[T]) Annotations(annotations ...schema.Annotation) *civilBuilder[T] {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Deprecated marks the field as deprecated. Deprecated fields are not
+// selected by default in queries, and their struct fields are annotated
+// with `deprecated` in the generated code.
+func (b *civilBuilder[T]) Deprecated(reason ...string) *civilBuilder[T] {
+	b.desc.Deprecated = true
+	if len(reason) > 0 {
+		b.desc.DeprecatedReason = strings.Join(reason, " ")
+	}
+	return b
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *civilBuilder[T]) Unique() *civilBuilder[T] {
+	b.desc.Unique = true
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *civilBuilder[T]) Descriptor() *Descriptor {
+	return b.desc
+}
+
+type (
+	netType interface {
+		netip.Addr | netip.Prefix | net.HardwareAddr
+	}
+	// netBuilder is the builder for network address fields.
+	netBuilder[T netType] struct {
+		desc *Descriptor
+	}
+)
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated struct.
+func (b *netBuilder[T]) Nillable() *netBuilder[T] {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *netBuilder[T]) Optional() *netBuilder[T] {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable fields are fields that can be set only in the creation of the entity.
+// i.e., no setters will be generated for the entity updaters (one and many).
+func (b *netBuilder[T]) Immutable() *netBuilder[T] {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *netBuilder[T]) Comment(c string) *netBuilder[T] {
+	b.desc.Comment = c
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *netBuilder[T]) StructTag(s string) *netBuilder[T] {
+	b.desc.Tag = s
+	return b
+}
+
+// Default sets the function that is applied to set default value
+// of the field on creation.
+func (b *netBuilder[T]) Default(fn func() T) *netBuilder[T] {
+	b.desc.Default = fn
+	return b
+}
+
+// Validate adds a validator for this field. Operation fails if the validation fails.
+//
+//	field.IP("addr").
+//		Validate(func(a netip.Addr) error {
+//			if !a.Is4() {
+//				return errors.New("only IPv4 addresses are allowed")
+//			}
+//			return nil
+//		})
+func (b *netBuilder[T]) Validate(fn func(T) error) *netBuilder[T] {
+	b.desc.Validators = append(b.desc.Validators, fn)
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *netBuilder[T]) StorageKey(key string) *netBuilder[T] {
+	b.desc.StorageKey = key
+	return b
+}
+
+// SchemaType overrides the default database type with a custom
+// schema type (per dialect). For example:
+//
+//	field.IP("addr").
+//		SchemaType(map[string]string{
+//			dialect.MySQL: "varchar(39)",
+//		})
+func (b *netBuilder[T]) SchemaType(types map[string]string) *netBuilder[T] {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *netBuilder[T]) Annotations(annotations ...schema.Annotation) *netBuilder[T] {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Deprecated marks the field as deprecated. Deprecated fields are not
+// selected by default in queries, and their struct fields are annotated
+// with `deprecated` in the generated code.
+func (b *netBuilder[T]) Deprecated(reason ...string) *netBuilder[T] {
+	b.desc.Deprecated = true
+	if len(reason) > 0 {
+		b.desc.DeprecatedReason = strings.Join(reason, " ")
+	}
+	return b
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *netBuilder[T]) Unique() *netBuilder[T] {
+	b.desc.Unique = true
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *netBuilder[T]) Descriptor() *Descriptor {
+	return b.desc
+}
+
+// nb is a generic helper method to share code between IP, Prefix and MAC builders.
+func nb[T netType](name string, t Type, vs TypeValueScanner[T]) *netBuilder[T] {
+	var typ T
+	b := &netBuilder[T]{&Descriptor{
+		Name:         name,
+		Info:         &TypeInfo{Type: t},
+		ValueScanner: vs,
+	}}
+	b.desc.goType(typ)
+	return b
+}
+
+// cb is a generic helper method to share code between Date, TimeOfDay and Duration builders.
+func cb[T civilType](name string, t Type) *civilBuilder[T] {
+	var typ T
+	b := &civilBuilder[T]{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: t},
+	}}
+	b.desc.goType(typ)
+	return b
+}
+
 // SchemaType overrides the default database type with a custom
 // schema type (per dialect) for time.
 //
@@ -1675,6 +1991,92 @@ func (f ValueScannerFunc[T, S]) FromValue(v driver.Value) (tv T, err error) {
 	return f.S(s)
 }
 
+// addrValueScanner stores netip.Addr values in their textual form.
+type addrValueScanner struct{}
+
+// Value implements the TypeValueScanner.Value method.
+func (addrValueScanner) Value(a netip.Addr) (driver.Value, error) {
+	if !a.IsValid() {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (addrValueScanner) ScanValue() ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (addrValueScanner) FromValue(v driver.Value) (a netip.Addr, err error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return a, fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	if !s.Valid {
+		return a, nil
+	}
+	// PostgreSQL "inet" values may hold a netmask. e.g. 10.1.0.1/16.
+	addr, _, _ := strings.Cut(s.String, "/")
+	return netip.ParseAddr(addr)
+}
+
+// prefixValueScanner stores netip.Prefix values in their textual form.
+type prefixValueScanner struct{}
+
+// Value implements the TypeValueScanner.Value method.
+func (prefixValueScanner) Value(p netip.Prefix) (driver.Value, error) {
+	if !p.IsValid() {
+		return nil, nil
+	}
+	return p.String(), nil
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (prefixValueScanner) ScanValue() ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (prefixValueScanner) FromValue(v driver.Value) (p netip.Prefix, err error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return p, fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	if !s.Valid {
+		return p, nil
+	}
+	return netip.ParsePrefix(s.String)
+}
+
+// macValueScanner stores net.HardwareAddr values in their textual form.
+type macValueScanner struct{}
+
+// Value implements the TypeValueScanner.Value method.
+func (macValueScanner) Value(a net.HardwareAddr) (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+// ScanValue implements the TypeValueScanner.ScanValue method.
+func (macValueScanner) ScanValue() ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements the TypeValueScanner.FromValue method.
+func (macValueScanner) FromValue(v driver.Value) (net.HardwareAddr, error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return nil, fmt.Errorf("unexpected input for FromValue: %T", v)
+	}
+	if !s.Valid {
+		return nil, nil
+	}
+	return net.ParseMAC(s.String)
+}
+
 // newT ensures the type is initialized.
 func newT(t any) any {
 	if rt := reflect.TypeOf(t); rt.Kind() == reflect.Ptr {
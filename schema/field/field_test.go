@@ -14,6 +14,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -24,6 +25,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/field/civil"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -600,6 +602,108 @@ func TestTime(t *testing.T) {
 	assert.Error(t, fd.Err)
 }
 
+func TestCivil(t *testing.T) {
+	today := civil.Date{Year: 2024, Month: time.May, Day: 1}
+	fd := field.Date("birthday").
+		Default(func() civil.Date { return today }).
+		Optional().
+		Nillable().
+		Comment("comment").
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "birthday", fd.Name)
+	assert.Equal(t, field.TypeDate, fd.Info.Type)
+	assert.Equal(t, "civil.Date", fd.Info.String())
+	assert.Equal(t, "entgo.io/ent/schema/field/civil", fd.Info.PkgPath)
+	assert.Equal(t, "civil", fd.Info.PkgName)
+	assert.False(t, fd.Info.Nillable)
+	assert.True(t, fd.Info.ValueScanner())
+	assert.True(t, fd.Info.Comparable())
+	assert.True(t, fd.Optional)
+	assert.True(t, fd.Nillable)
+	assert.Equal(t, today, fd.Default.(func() civil.Date)())
+	assert.Equal(t, "comment", fd.Comment)
+
+	fd = field.TimeOfDay("opens_at").
+		UpdateDefault(func() civil.Time { return civil.Time{Hour: 9} }).
+		Validate(func(civil.Time) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypeTimeOfDay, fd.Info.Type)
+	assert.Equal(t, "civil.Time", fd.Info.String())
+	assert.Equal(t, civil.Time{Hour: 9}, fd.UpdateDefault.(func() civil.Time)())
+	assert.Len(t, fd.Validators, 1)
+
+	fd = field.Duration("timeout").
+		SchemaType(map[string]string{dialect.MySQL: "bigint unsigned"}).
+		Unique().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypeDuration, fd.Info.Type)
+	assert.Equal(t, "civil.Duration", fd.Info.String())
+	assert.Equal(t, reflect.Int64, fd.Info.RType.Kind)
+	assert.True(t, fd.Info.Valuer())
+	assert.True(t, fd.Unique)
+	assert.Equal(t, "bigint unsigned", fd.SchemaType[dialect.MySQL])
+}
+
+func TestNet(t *testing.T) {
+	fd := field.IP("addr").
+		Unique().
+		Validate(func(a netip.Addr) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypeIP, fd.Info.Type)
+	assert.Equal(t, "netip.Addr", fd.Info.String())
+	assert.Equal(t, "net/netip", fd.Info.PkgPath)
+	assert.True(t, fd.Info.Comparable())
+	assert.True(t, fd.Unique)
+	assert.Len(t, fd.Validators, 1)
+	vs, ok := fd.ValueScanner.(field.TypeValueScanner[netip.Addr])
+	require.True(t, ok)
+	v, err := vs.Value(netip.MustParseAddr("10.0.0.1"))
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", v)
+	v, err = vs.Value(netip.Addr{})
+	require.NoError(t, err)
+	require.Nil(t, v)
+	a, err := vs.FromValue(&sql.NullString{String: "10.1.0.1/16", Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, netip.MustParseAddr("10.1.0.1"), a)
+	a, err = vs.FromValue(&sql.NullString{})
+	require.NoError(t, err)
+	require.False(t, a.IsValid())
+
+	fd = field.Prefix("subnet").Optional().Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypePrefix, fd.Info.Type)
+	assert.Equal(t, "netip.Prefix", fd.Info.String())
+	assert.True(t, fd.Optional)
+	pvs, ok := fd.ValueScanner.(field.TypeValueScanner[netip.Prefix])
+	require.True(t, ok)
+	v, err = pvs.Value(netip.MustParsePrefix("2001:db8::/32"))
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::/32", v)
+	p, err := pvs.FromValue(&sql.NullString{String: "10.0.0.0/8", Valid: true})
+	require.NoError(t, err)
+	require.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), p)
+	_, err = pvs.FromValue(&sql.NullString{String: "10.0.0.0", Valid: true})
+	require.Error(t, err)
+
+	fd = field.MAC("hwaddr").Nillable().Optional().Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypeMAC, fd.Info.Type)
+	assert.Equal(t, "net.HardwareAddr", fd.Info.String())
+	assert.True(t, fd.Info.Nillable)
+	mvs, ok := fd.ValueScanner.(field.TypeValueScanner[net.HardwareAddr])
+	require.True(t, ok)
+	mac, err := mvs.FromValue(&sql.NullString{String: "08:00:2b:01:02:03", Valid: true})
+	require.NoError(t, err)
+	v, err = mvs.Value(mac)
+	require.NoError(t, err)
+	require.Equal(t, "08:00:2b:01:02:03", v)
+}
+
 func TestJSON(t *testing.T) {
 	fd := field.JSON("name", map[string]string{}).
 		Optional().
@@ -943,7 +1047,7 @@ func TestTypeString(t *testing.T) {
 	assert.Equal(t, "bool", typ.String())
 	typ = field.TypeInvalid
 	assert.Equal(t, "invalid", typ.String())
-	typ = 21
+	typ = 27
 	assert.Equal(t, "invalid", typ.String())
 }
 
@@ -952,6 +1056,8 @@ func TestTypeNumeric(t *testing.T) {
 	assert.False(t, typ.Numeric())
 	typ = field.TypeUint8
 	assert.True(t, typ.Numeric())
+	typ = field.TypeDuration
+	assert.False(t, typ.Numeric())
 }
 
 func TestTypeValid(t *testing.T) {
@@ -959,7 +1065,7 @@ func TestTypeValid(t *testing.T) {
 	assert.True(t, typ.Valid())
 	typ = 0
 	assert.False(t, typ.Valid())
-	typ = 21
+	typ = 27
 	assert.False(t, typ.Valid())
 }
 
@@ -972,7 +1078,9 @@ func TestTypeConstName(t *testing.T) {
 	assert.Equal(t, "TypeInt64", typ.ConstName())
 	typ = field.TypeOther
 	assert.Equal(t, "TypeOther", typ.ConstName())
-	typ = 21
+	typ = field.TypeTimeOfDay
+	assert.Equal(t, "TypeTimeOfDay", typ.ConstName())
+	typ = 27
 	assert.Equal(t, "invalid", typ.ConstName())
 }
 
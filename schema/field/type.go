@@ -36,6 +36,12 @@ const (
 	TypeUint64
 	TypeFloat32
 	TypeFloat64
+	TypeDate
+	TypeTimeOfDay
+	TypeDuration
+	TypeIP
+	TypePrefix
+	TypeMAC
 	endTypes
 )
 
@@ -49,7 +55,7 @@ func (t Type) String() string {
 
 // Numeric reports if the given type is a numeric type.
 func (t Type) Numeric() bool {
-	return t >= TypeInt8 && t < endTypes
+	return t >= TypeInt8 && t <= TypeFloat64
 }
 
 // Float reports if the given type is a float type.
@@ -136,7 +142,8 @@ func (t TypeInfo) Valuer() bool {
 // Comparable reports whether values of this type are comparable.
 func (t TypeInfo) Comparable() bool {
 	switch t.Type {
-	case TypeBool, TypeTime, TypeUUID, TypeEnum, TypeString:
+	case TypeBool, TypeTime, TypeUUID, TypeEnum, TypeString, TypeDate, TypeTimeOfDay, TypeDuration,
+		TypeIP, TypePrefix, TypeMAC:
 		return true
 	case TypeOther:
 		// Always accept custom types as comparable on the database side.
@@ -157,35 +164,47 @@ func (t TypeInfo) Stringer() bool {
 
 var (
 	typeNames = [...]string{
-		TypeInvalid: "invalid",
-		TypeBool:    "bool",
-		TypeTime:    "time.Time",
-		TypeJSON:    "json.RawMessage",
-		TypeUUID:    "[16]byte",
-		TypeBytes:   "[]byte",
-		TypeEnum:    "string",
-		TypeString:  "string",
-		TypeOther:   "other",
-		TypeInt:     "int",
-		TypeInt8:    "int8",
-		TypeInt16:   "int16",
-		TypeInt32:   "int32",
-		TypeInt64:   "int64",
-		TypeUint:    "uint",
-		TypeUint8:   "uint8",
-		TypeUint16:  "uint16",
-		TypeUint32:  "uint32",
-		TypeUint64:  "uint64",
-		TypeFloat32: "float32",
-		TypeFloat64: "float64",
+		TypeInvalid:   "invalid",
+		TypeBool:      "bool",
+		TypeTime:      "time.Time",
+		TypeJSON:      "json.RawMessage",
+		TypeUUID:      "[16]byte",
+		TypeBytes:     "[]byte",
+		TypeEnum:      "string",
+		TypeString:    "string",
+		TypeOther:     "other",
+		TypeInt:       "int",
+		TypeInt8:      "int8",
+		TypeInt16:     "int16",
+		TypeInt32:     "int32",
+		TypeInt64:     "int64",
+		TypeUint:      "uint",
+		TypeUint8:     "uint8",
+		TypeUint16:    "uint16",
+		TypeUint32:    "uint32",
+		TypeUint64:    "uint64",
+		TypeFloat32:   "float32",
+		TypeFloat64:   "float64",
+		TypeDate:      "civil.Date",
+		TypeTimeOfDay: "civil.Time",
+		TypeDuration:  "civil.Duration",
+		TypeIP:        "netip.Addr",
+		TypePrefix:    "netip.Prefix",
+		TypeMAC:       "net.HardwareAddr",
 	}
 	constNames = [...]string{
-		TypeJSON:  "TypeJSON",
-		TypeUUID:  "TypeUUID",
-		TypeTime:  "TypeTime",
-		TypeEnum:  "TypeEnum",
-		TypeBytes: "TypeBytes",
-		TypeOther: "TypeOther",
+		TypeJSON:      "TypeJSON",
+		TypeUUID:      "TypeUUID",
+		TypeTime:      "TypeTime",
+		TypeEnum:      "TypeEnum",
+		TypeBytes:     "TypeBytes",
+		TypeOther:     "TypeOther",
+		TypeDate:      "TypeDate",
+		TypeTimeOfDay: "TypeTimeOfDay",
+		TypeDuration:  "TypeDuration",
+		TypeIP:        "TypeIP",
+		TypePrefix:    "TypePrefix",
+		TypeMAC:       "TypeMAC",
 	}
 )
 